@@ -5,4 +5,17 @@ go 1.18
 require (
 	github.com/c2h5oh/datasize v0.0.0-20220606134207-859f65c6625b
 	golang.org/x/exp v0.0.0-20221019170559-20944726eadf
+	google.golang.org/protobuf v1.28.1
+	tinygo.org/x/bluetooth v0.6.0
+)
+
+require (
+	github.com/fatih/structs v1.1.0 // indirect
+	github.com/go-ole/go-ole v1.2.6 // indirect
+	github.com/godbus/dbus/v5 v5.0.3 // indirect
+	github.com/muka/go-bluetooth v0.0.0-20220830075246-0746e3a1ea53 // indirect
+	github.com/saltosystems/winrt-go v0.0.0-20220826130236-ddc8202da421 // indirect
+	github.com/sirupsen/logrus v1.9.0 // indirect
+	github.com/tinygo-org/cbgo v0.0.4 // indirect
+	golang.org/x/sys v0.0.0-20220829200755-d48e67d00261 // indirect
 )
@@ -0,0 +1,238 @@
+package camera
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/command"
+	"github.com/thatpix3l/persephone/pkg/proto"
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/settings"
+	"github.com/thatpix3l/persephone/pkg/transport"
+)
+
+// Send a command, as built by command.Action, discarding its response beyond the result code
+func (c *Camera) command(ctx context.Context, packets [][]byte, err error) error {
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Request(ctx, transport.Command, packets)
+	return err
+
+}
+
+// Send a command, as built by command.Action, and decode its response
+func (c *Camera) commandResponse(ctx context.Context, packets [][]byte, err error) (command.Response, error) {
+
+	r := command.NewResponse()
+	if err != nil {
+		return r, err
+	}
+
+	message, err := c.Request(ctx, transport.Command, packets)
+	if err != nil {
+		return r, err
+	}
+
+	return r, r.Unmarshal(message)
+
+}
+
+func (c *Camera) SetShutter(ctx context.Context, on bool) error {
+	if on {
+		packets, err := command.Action.TurnShutterOn()
+		return c.command(ctx, packets, err)
+	}
+	packets, err := command.Action.TurnShutterOff()
+	return c.command(ctx, packets, err)
+}
+
+func (c *Camera) Sleep(ctx context.Context) error {
+	packets, err := command.Action.Sleep()
+	return c.command(ctx, packets, err)
+}
+
+func (c *Camera) SetDateTime(ctx context.Context, t time.Time) error {
+	packets, err := command.Action.SetDateTime(t)
+	return c.command(ctx, packets, err)
+}
+
+func (c *Camera) GetDateTime(ctx context.Context) (time.Time, error) {
+	packets, err := command.Action.GetDateTime()
+	r, err := c.commandResponse(ctx, packets, err)
+	return r.DateTime, err
+}
+
+func (c *Camera) SetLocalDateTime(ctx context.Context, t time.Time) error {
+	packets, err := command.Action.SetLocalDateTime(t)
+	return c.command(ctx, packets, err)
+}
+
+func (c *Camera) SetAccessPoint(ctx context.Context, on bool) error {
+	if on {
+		packets, err := command.Action.TurnAccessPointOn()
+		return c.command(ctx, packets, err)
+	}
+	packets, err := command.Action.TurnAccessPointOff()
+	return c.command(ctx, packets, err)
+}
+
+func (c *Camera) HilightMoment(ctx context.Context) error {
+	packets, err := command.Action.HilightMoment()
+	return c.command(ctx, packets, err)
+}
+
+func (c *Camera) GetHardwareInfo(ctx context.Context) (command.Hardware, error) {
+	packets, err := command.Action.GetHardwareInfo()
+	r, err := c.commandResponse(ctx, packets, err)
+	return r.Hardware, err
+}
+
+func (c *Camera) LoadPresetGroup(ctx context.Context, id proto.EnumPresetGroup) error {
+	packets, err := command.Action.LoadPresetGroup(uint16(id))
+	return c.command(ctx, packets, err)
+}
+
+// Load the preset "id", as listed by GetPresetStatus
+func (c *Camera) LoadPreset(ctx context.Context, id int32) error {
+	packets, err := command.Action.LoadPreset(uint32(id))
+	return c.command(ctx, packets, err)
+}
+
+func (c *Camera) GetVersion(ctx context.Context) (command.SemVer, error) {
+	packets, err := command.Action.GetVersion()
+	r, err := c.commandResponse(ctx, packets, err)
+	return r.OpenGoProVersion, err
+}
+
+// Apply a setting, e.g. SetSetting(ctx, settings.IDVideoResolution, byte(settings.Res4K))
+func (c *Camera) SetSetting(ctx context.Context, id settings.ID, value byte) error {
+
+	packets, err := settings.Action.Set(id, value)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Request(ctx, transport.Settings, packets)
+	return err
+
+}
+
+// Send a query, as built by query.Action, returning its response once it is decoded into the camera's live state
+func (c *Camera) queryResponse(ctx context.Context, packets [][]byte, err error) ([]byte, error) {
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Request(ctx, transport.Query, packets)
+
+}
+
+// Send a query, as built by query.Action, discarding its response once it is decoded into the camera's live state
+func (c *Camera) query(ctx context.Context, packets [][]byte, err error) error {
+	_, err = c.queryResponse(ctx, packets, err)
+	return err
+}
+
+// Get the values of the given statuses, or every status if none are given.
+// Only the statuses in the reply are set in the returned response, see the report for which those are.
+func (c *Camera) GetStatus(ctx context.Context, ids ...query.StatusID) (query.Response, query.Report, error) {
+
+	var r query.Response
+
+	packets, err := query.Action.GetStatusValues(ids...)
+	message, err := c.queryResponse(ctx, packets, err)
+	if err != nil {
+		return r, query.Report{}, err
+	}
+
+	report, err := query.Unmarshal(message, &r)
+	if err != nil {
+		return r, report, fmt.Errorf("decoding statuses: %w", err)
+	}
+
+	return r, report, nil
+
+}
+
+// Get the values of the given settings, or every setting if none are given.
+// Only the settings in the reply are set in the returned response, see the report for which those are.
+func (c *Camera) GetSettings(ctx context.Context, ids ...settings.ID) (settings.Response, settings.Report, error) {
+
+	var r settings.Response
+
+	packets, err := query.Action.GetSettingValues(ids...)
+	message, err := c.queryResponse(ctx, packets, err)
+	if err != nil {
+		return r, settings.Report{}, err
+	}
+
+	report, err := settings.Unmarshal(message, &r)
+	if err != nil {
+		return r, report, fmt.Errorf("decoding settings: %w", err)
+	}
+
+	return r, report, nil
+
+}
+
+// Get every status and setting value, decoding them into "statuses" and "s", either of which may be nil to skip them.
+// The values are decoded into the camera's live state as well.
+//
+// Every value is decoded independently, so an unknown or malformed value does not prevent the rest from being decoded, and is instead recorded in the returned reports.
+func (c *Camera) GetState(ctx context.Context, statuses *query.Response, s *settings.Response) (query.Report, settings.Report, error) {
+
+	var statusReport query.Report
+	var settingReport settings.Report
+
+	if statuses != nil {
+		packets, err := query.Action.GetAllStatusValues()
+		message, err := c.queryResponse(ctx, packets, err)
+		if err != nil {
+			return statusReport, settingReport, err
+		}
+		if statusReport, err = query.Unmarshal(message, statuses); err != nil {
+			return statusReport, settingReport, fmt.Errorf("decoding statuses: %w", err)
+		}
+	}
+
+	if s != nil {
+		packets, err := query.Action.GetAllSettingValues()
+		message, err := c.queryResponse(ctx, packets, err)
+		if err != nil {
+			return statusReport, settingReport, err
+		}
+		if settingReport, err = settings.Unmarshal(message, s); err != nil {
+			return statusReport, settingReport, fmt.Errorf("decoding settings: %w", err)
+		}
+	}
+
+	return statusReport, settingReport, nil
+
+}
+
+// Ask the camera to push updates for the given statuses, see OnUpdate
+func (c *Camera) RegisterStatusUpdates(ctx context.Context, ids ...query.StatusID) error {
+	packets, err := query.Action.RegisterStatusUpdates(ids...)
+	return c.query(ctx, packets, err)
+}
+
+func (c *Camera) UnregisterStatusUpdates(ctx context.Context, ids ...query.StatusID) error {
+	packets, err := query.Action.UnregisterStatusUpdates(ids...)
+	return c.query(ctx, packets, err)
+}
+
+// Ask the camera to push updates for the given settings, see OnUpdate
+func (c *Camera) RegisterSettingUpdates(ctx context.Context, ids ...settings.ID) error {
+	packets, err := query.Action.RegisterSettingUpdates(ids...)
+	return c.query(ctx, packets, err)
+}
+
+func (c *Camera) UnregisterSettingUpdates(ctx context.Context, ids ...settings.ID) error {
+	packets, err := query.Action.UnregisterSettingUpdates(ids...)
+	return c.query(ctx, packets, err)
+}
@@ -0,0 +1,419 @@
+// High-level client for controlling a camera over any transport, correlating every request with its response
+package camera
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/control"
+	"github.com/thatpix3l/persephone/pkg/packet"
+	"github.com/thatpix3l/persephone/pkg/proto"
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/settings"
+	"github.com/thatpix3l/persephone/pkg/transport"
+)
+
+// Timeout applied to requests whose context has no deadline of its own
+const DefaultTimeout = 5 * time.Second
+
+// Timeout applied to waits on the camera's notifications whose context has no deadline of its own, e.g. for a livestream to start
+const DefaultWaitTimeout = 60 * time.Second
+
+// Returned when the camera answers a request with a non-zero result code
+type ResultError struct {
+	Channel transport.Channel
+	ID      byte // Command, setting or query ID of the failed request
+	Result  byte
+}
+
+func (e *ResultError) Error() string {
+
+	reason := fmt.Sprintf("result %d", e.Result)
+	switch e.Result {
+	case 1:
+		reason = "error"
+	case 2:
+		reason = "invalid parameter"
+	}
+
+	return fmt.Sprintf("%v request %#x failed: %s", e.Channel, e.ID, reason)
+
+}
+
+// Identifies the response a request is waiting on
+type responseKey struct {
+	channel transport.Channel
+	id      byte
+	action  byte // Response action ID of protobuf messages, whose ID is only their feature ID
+}
+
+// Return the key identifying "message", a request or response as reassembled by packet.Accumulator
+func keyOf(channel transport.Channel, message []byte) (responseKey, error) {
+
+	if len(message) == 0 {
+		return responseKey{}, fmt.Errorf("%v message is empty", channel)
+	}
+
+	if !proto.IsFeature(channel, message[0]) {
+		return responseKey{channel: channel, id: message[0]}, nil
+	}
+
+	if len(message) < 2 {
+		return responseKey{}, fmt.Errorf("%v protobuf message %#x has no action ID", channel, message[0])
+	}
+
+	// Requests and their responses share a key, the response's action ID being the request's with the high bit set
+	return responseKey{channel: channel, id: message[0], action: proto.ResponseAction(message[1])}, nil
+
+}
+
+// Camera shares its common operations with http.Client
+var _ control.Controller = (*Camera)(nil)
+
+type Camera struct {
+	transport   transport.Transport
+	Timeout     time.Duration // Applied to requests whose context has no deadline, DefaultTimeout if zero
+	WaitTimeout time.Duration // Applied to waits on notifications whose context has no deadline, DefaultWaitTimeout if zero
+
+	accumulatorsMu sync.Mutex
+	accumulators   packet.Accumulators[transport.Channel]
+
+	writeMu   sync.Mutex // Held while registering a request and writing it
+	pendingMu sync.Mutex
+	pending   map[responseKey][]chan []byte // Requests waiting on a response, oldest first
+
+	stateMu  sync.RWMutex
+	statuses query.Response
+	settings settings.Response
+
+	listenersMu    sync.Mutex
+	listeners      map[int]func(query.Update)
+	protoListeners map[int]protoListener
+	nextID         int
+
+	errs chan error
+}
+
+// Return a camera communicating over "t", subscribing to the response characteristic of every channel
+func New(t transport.Transport) (*Camera, error) {
+
+	c := &Camera{
+		transport:      t,
+		pending:        map[responseKey][]chan []byte{},
+		listeners:      map[int]func(query.Update){},
+		protoListeners: map[int]protoListener{},
+		errs:           make(chan error, 16),
+	}
+
+	for _, channel := range transport.Channels {
+		channel := channel
+		if err := t.Subscribe(channel, func(p []byte) {
+			c.receive(channel, p)
+		}); err != nil {
+			return nil, fmt.Errorf("subscribing to %v: %w", channel, err)
+		}
+	}
+
+	return c, nil
+
+}
+
+// Disconnect from the camera
+func (c *Camera) Close() error {
+	return c.transport.Close()
+}
+
+// Return errors from notifications that could not be reassembled or decoded, and so never reached a request.
+// Errors are dropped while the channel is full.
+func (c *Camera) Errors() <-chan error {
+	return c.errs
+}
+
+func (c *Camera) reportError(err error) {
+	select {
+	case c.errs <- err:
+	default:
+	}
+}
+
+// Handle a single raw notification from the transport
+func (c *Camera) receive(channel transport.Channel, p []byte) {
+
+	c.accumulatorsMu.Lock()
+	message, err := c.accumulators.Accumulate(channel, p)
+	c.accumulatorsMu.Unlock()
+
+	if err != nil {
+		c.reportError(fmt.Errorf("%v notification: %w", channel, err))
+	}
+	if message == nil {
+		return
+	}
+	if len(message) == 0 {
+		c.reportError(fmt.Errorf("%v notification is empty", channel))
+		return
+	}
+
+	if channel == transport.Query {
+		c.updateState(message)
+	}
+
+	if proto.IsFeature(channel, message[0]) {
+		c.notifyProto(message)
+	}
+
+	key, err := keyOf(channel, message)
+	if err != nil {
+		c.reportError(err)
+		return
+	}
+
+	c.pendingMu.Lock()
+	waiters := c.pending[key]
+	if len(waiters) > 0 {
+		c.pending[key] = waiters[1:]
+	}
+	c.pendingMu.Unlock()
+
+	if len(waiters) > 0 {
+		waiters[0] <- message
+	}
+
+}
+
+// Decode query responses carrying status or setting values into the camera's live state, notifying listeners of asynchronous updates
+func (c *Camera) updateState(message []byte) {
+
+	var update query.Update
+	var err error
+
+	c.stateMu.Lock()
+	switch message[0] {
+
+	case query.IDGetStatusValues:
+		update.QueryID = message[0]
+		update.Statuses, err = query.Unmarshal(message, &c.statuses)
+
+	case query.IDGetSettingValues:
+		update.QueryID = message[0]
+		update.Settings, err = settings.Unmarshal(message, &c.settings)
+
+	case query.IDRegisterStatusUpdates, query.IDStatusUpdate, query.IDRegisterSettingUpdates, query.IDSettingUpdate:
+		update, err = query.UnmarshalUpdate(message, &c.statuses, &c.settings)
+
+	default:
+		c.stateMu.Unlock()
+		return
+
+	}
+	c.stateMu.Unlock()
+
+	if err != nil {
+		c.reportError(err)
+		return
+	}
+
+	if message[0] == query.IDStatusUpdate || message[0] == query.IDSettingUpdate {
+		c.listenersMu.Lock()
+		listeners := make([]func(query.Update), 0, len(c.listeners))
+		for _, listener := range c.listeners {
+			listeners = append(listeners, listener)
+		}
+		c.listenersMu.Unlock()
+
+		for _, listener := range listeners {
+			listener(update)
+		}
+	}
+
+}
+
+// Call "listener" with every asynchronous status or setting update pushed by the camera, until the returned function is called
+func (c *Camera) OnUpdate(listener func(query.Update)) func() {
+
+	c.listenersMu.Lock()
+	id := c.nextID
+	c.nextID++
+	c.listeners[id] = listener
+	c.listenersMu.Unlock()
+
+	return func() {
+		c.listenersMu.Lock()
+		delete(c.listeners, id)
+		c.listenersMu.Unlock()
+	}
+
+}
+
+// Listener for protobuf notifications with a given feature and action ID
+type protoListener struct {
+	feature  byte
+	action   byte
+	listener func(payload []byte)
+}
+
+// Call the listeners registered for the feature and action IDs of "message", a complete protobuf message
+func (c *Camera) notifyProto(message []byte) {
+
+	feature, action, payload, err := proto.Split(message)
+	if err != nil {
+		c.reportError(err)
+		return
+	}
+
+	c.listenersMu.Lock()
+	var listeners []func([]byte)
+	for _, l := range c.protoListeners {
+		if l.feature == feature && l.action == action {
+			listeners = append(listeners, l.listener)
+		}
+	}
+	c.listenersMu.Unlock()
+
+	for _, listener := range listeners {
+		listener(payload)
+	}
+
+}
+
+// Call "listener" with the encoded payload of every protobuf message with "feature" and "action" IDs the camera sends, until the returned function is called.
+// Used for notifications such as proto.ActionNotifyPresetStatus, pushed after registering for them.
+func (c *Camera) OnNotification(feature byte, action byte, listener func(payload []byte)) func() {
+
+	c.listenersMu.Lock()
+	id := c.nextID
+	c.nextID++
+	c.protoListeners[id] = protoListener{feature: feature, action: action, listener: listener}
+	c.listenersMu.Unlock()
+
+	return func() {
+		c.listenersMu.Lock()
+		delete(c.protoListeners, id)
+		c.listenersMu.Unlock()
+	}
+
+}
+
+// Return a copy of the most recently received status values
+func (c *Camera) Statuses() query.Response {
+	c.stateMu.RLock()
+	defer c.stateMu.RUnlock()
+	return c.statuses
+}
+
+// Return a copy of the most recently received setting values
+func (c *Camera) Settings() settings.Response {
+	c.stateMu.RLock()
+	defer c.stateMu.RUnlock()
+	return c.settings
+}
+
+// Return the timeout applied to requests whose context has no deadline
+func (c *Camera) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return DefaultTimeout
+	}
+	return c.Timeout
+}
+
+// Return the timeout applied to waits on notifications whose context has no deadline
+func (c *Camera) waitTimeout() time.Duration {
+	if c.WaitTimeout == 0 {
+		return DefaultWaitTimeout
+	}
+	return c.WaitTimeout
+}
+
+// Undo a registration for notifications with "unregister", bounded by the request timeout.
+// Best effort, as the camera stops notifying on disconnect regardless.
+func (c *Camera) unregister(unregister func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout())
+	defer cancel()
+	unregister(ctx)
+}
+
+// Return "ctx" bounded by "timeout" if it has no deadline of its own
+func withDefaultTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// Remove "waiter" from the requests waiting on "key", if still present
+func (c *Camera) abandon(key responseKey, waiter chan []byte) {
+
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+
+	waiters := c.pending[key]
+	for i, w := range waiters {
+		if w == waiter {
+			c.pending[key] = append(waiters[:i:i], waiters[i+1:]...)
+			return
+		}
+	}
+
+}
+
+// Write "packets" to "channel" and wait for the response with the same command, setting or query ID, returning the response message without its headers.
+// Protobuf requests wait for the response with the same feature ID and matching response action ID instead.
+//
+// Errors if the write fails, the context is done before the response arrives, or the camera answers a TLV request with a non-zero result code.
+func (c *Camera) Request(ctx context.Context, channel transport.Channel, packets [][]byte) ([]byte, error) {
+
+	// Reassemble the request to learn the ID its response will carry
+	var accumulator packet.Accumulator
+	var request []byte
+	for _, p := range packets {
+		message, err := accumulator.Accumulate(p)
+		if err != nil {
+			return nil, fmt.Errorf("malformed request: %w", err)
+		}
+		request = message
+	}
+	if request == nil {
+		return nil, errors.New("malformed request: packets do not form a complete message")
+	}
+
+	key, err := keyOf(channel, request)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := withDefaultTimeout(ctx, c.timeout())
+	defer cancel()
+
+	// Responses with the same key are matched oldest first, so requests must be written in the order they wait
+	waiter := make(chan []byte, 1)
+	c.writeMu.Lock()
+	c.pendingMu.Lock()
+	c.pending[key] = append(c.pending[key], waiter)
+	c.pendingMu.Unlock()
+	err = c.transport.Write(channel, packets)
+	c.writeMu.Unlock()
+
+	if err != nil {
+		c.abandon(key, waiter)
+		return nil, err
+	}
+
+	select {
+
+	case <-ctx.Done():
+		c.abandon(key, waiter)
+		return nil, fmt.Errorf("waiting for %v response %#x: %w", channel, key.id, ctx.Err())
+
+	case response := <-waiter:
+		// Protobuf responses carry their result inside the payload, decoded by the caller
+		if !proto.IsFeature(channel, key.id) && len(response) >= 2 && response[1] != 0 {
+			return response, &ResultError{Channel: channel, ID: key.id, Result: response[1]}
+		}
+		return response, nil
+
+	}
+
+}
@@ -0,0 +1,254 @@
+package camera
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/settings"
+	"github.com/thatpix3l/persephone/pkg/simulator"
+	"github.com/thatpix3l/persephone/pkg/transport"
+)
+
+// Return a camera connected to a fresh simulator, fragmenting its notifications into small packets to exercise reassembly
+func newTestCamera(t *testing.T) (*Camera, *simulator.Simulator) {
+
+	sim := simulator.New()
+	if err := sim.SetMTU(8); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(sim)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	return c, sim
+
+}
+
+func TestRequestCorrelation(t *testing.T) {
+
+	c, _ := newTestCamera(t)
+	ctx := context.Background()
+
+	// Requests sharing a query ID are answered in the order they are written, each with the statuses it asked for
+	ids := []query.StatusID{1, 2, 10, 33, 70, 82}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*len(ids))
+
+	for _, id := range ids {
+		id := id
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			packets, err := query.Action.GetStatusValues(id)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			response, err := c.Request(ctx, transport.Query, packets)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if len(response) < 3 || response[0] != query.IDGetStatusValues || query.StatusID(response[2]) != id {
+				errs <- errors.New("response does not carry the status it was asked for")
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetHardwareInfo(ctx); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	for key, waiters := range c.pending {
+		if len(waiters) != 0 {
+			t.Errorf("%d requests still waiting on %+v", len(waiters), key)
+		}
+	}
+
+}
+
+func TestRequestResultError(t *testing.T) {
+
+	c, sim := newTestCamera(t)
+	ctx := context.Background()
+
+	sim.FailNext(transport.Command, 0x01, settings.ResultError)
+
+	err := c.SetShutter(ctx, true)
+
+	var resultErr *ResultError
+	if !errors.As(err, &resultErr) {
+		t.Fatalf("got error %v, want a ResultError", err)
+	}
+	if resultErr.Channel != transport.Command || resultErr.ID != 0x01 || resultErr.Result != byte(settings.ResultError) {
+		t.Errorf("got %+v, want command 0x01 failing with result %v", resultErr, settings.ResultError)
+	}
+
+	// Only the next request fails
+	if err := c.SetShutter(ctx, true); err != nil {
+		t.Error(err)
+	}
+
+}
+
+func TestRequestTimeout(t *testing.T) {
+
+	c, _ := newTestCamera(t)
+	c.Timeout = 20 * time.Millisecond
+
+	// The simulator never answers network management requests
+	_, err := c.Request(context.Background(), transport.NetworkManagement, [][]byte{{0x02, 0x02, 0x02}})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	if waiters := c.pending[responseKey{channel: transport.NetworkManagement, id: 0x02, action: 0x82}]; len(waiters) != 0 {
+		t.Errorf("%d abandoned requests still waiting", len(waiters))
+	}
+
+}
+
+func TestStateUpdates(t *testing.T) {
+
+	c, sim := newTestCamera(t)
+	ctx := context.Background()
+
+	updates := make(chan query.Update, 4)
+	defer c.OnUpdate(func(u query.Update) { updates <- u })()
+
+	if err := c.RegisterStatusUpdates(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetShutter(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case u := <-updates:
+		if u.QueryID != query.IDStatusUpdate || !c.Statuses().IsEncoding {
+			t.Errorf("got update %+v, want IsEncoding pushed", u)
+		}
+	default:
+		t.Error("no status update was pushed")
+	}
+
+	if err := c.SetShutter(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetSetting(ctx, settings.IDFPS, byte(settings.FPS60)); err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := sim.Setting(settings.IDFPS); value != byte(settings.FPS60) {
+		t.Errorf("simulator has FPS %d, want %d", value, settings.FPS60)
+	}
+
+	var statuses query.Response
+	var s settings.Response
+	statusReport, settingReport, err := c.GetState(ctx, &statuses, &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !statusReport.OK() || !settingReport.OK() {
+		t.Errorf("reports are not OK: %+v, %+v", statusReport, settingReport)
+	}
+	if statuses != sim.Statuses() || s.FPS != settings.FPS60 {
+		t.Error("state does not match the simulator")
+	}
+
+}
+
+func TestEmptyNotification(t *testing.T) {
+
+	c, sim := newTestCamera(t)
+
+	// A complete message of no bytes has no ID to dispatch on
+	sim.Inject(transport.Query, [][]byte{{0x00}})
+
+	select {
+	case err := <-c.Errors():
+		if err == nil {
+			t.Error("got a nil error")
+		}
+	default:
+		t.Error("an empty notification was not reported")
+	}
+
+}
+
+func TestGetStatus(t *testing.T) {
+
+	c, sim := newTestCamera(t)
+	ctx := context.Background()
+
+	if err := c.SetShutter(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses, report, err := c.GetStatus(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Present) != 1 || report.Present[0] != 10 || !statuses.IsEncoding {
+		t.Errorf("got statuses %+v with report %+v, want only IsEncoding set", statuses, report)
+	}
+
+	s, settingReport, err := c.GetSettings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := sim.Setting(settings.IDFPS); !settingReport.OK() || byte(s.FPS) != want {
+		t.Errorf("got FPS %d with report %+v, want %d", s.FPS, settingReport, want)
+	}
+
+}
+
+func TestScanAccessPointsTimeout(t *testing.T) {
+
+	c, _ := newTestCamera(t)
+	c.Timeout = time.Hour
+	c.WaitTimeout = 20 * time.Millisecond
+
+	// The simulator never answers network management requests, so only the default wait timeout ends the scan
+	if _, err := c.ScanAccessPoints(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+}
+
+func TestGetCOHNCredentialsTimeout(t *testing.T) {
+
+	c, _ := newTestCamera(t)
+	c.Timeout = time.Hour
+	c.WaitTimeout = 20 * time.Millisecond
+
+	if _, err := c.GetCOHNCredentials(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+}
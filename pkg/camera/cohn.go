@@ -0,0 +1,114 @@
+package camera
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thatpix3l/persephone/pkg/cohn"
+	"github.com/thatpix3l/persephone/pkg/proto"
+)
+
+// Get the COHN status, optionally registering for notifications when it changes, see OnCOHNStatus
+func (c *Camera) GetCOHNStatus(ctx context.Context, register bool) (*proto.NotifyCOHNStatus, error) {
+	r := &proto.NotifyCOHNStatus{}
+	packets, err := proto.Action.GetCOHNStatus(register)
+	err = c.protoRequest(ctx, proto.FeatureQuery, proto.ActionGetCOHNStatus, packets, err, r)
+	return r, err
+}
+
+// Call "listener" with every COHN status the camera pushes, until the returned function is called. The camera only pushes them after GetCOHNStatus registers for them.
+func (c *Camera) OnCOHNStatus(listener func(*proto.NotifyCOHNStatus)) func() {
+	return onProto(c, proto.FeatureQuery, proto.ActionNotifyCOHNStatus, "COHN status", listener)
+}
+
+// Create the camera's COHN certificate, replacing the current one if "override" is true
+func (c *Camera) CreateCOHNCertificate(ctx context.Context, override bool) error {
+	packets, err := proto.Action.CreateCOHNCertificate(override)
+	return c.protoCommand(ctx, proto.FeatureCommand, proto.ActionCreateCOHNCert, packets, err)
+}
+
+func (c *Camera) ClearCOHNCertificate(ctx context.Context) error {
+	packets, err := proto.Action.ClearCOHNCertificate()
+	return c.protoCommand(ctx, proto.FeatureCommand, proto.ActionClearCOHNCert, packets, err)
+}
+
+// Get the camera's PEM encoded COHN root CA certificate
+func (c *Camera) GetCOHNCertificate(ctx context.Context) ([]byte, error) {
+
+	r := &proto.ResponseCOHNCert{}
+	packets, err := proto.Action.GetCOHNCertificate()
+	if err := c.protoRequest(ctx, proto.FeatureQuery, proto.ActionGetCOHNCert, packets, err, r); err != nil {
+		return nil, err
+	}
+
+	return []byte(r.GetCert()), r.GetResult().Err()
+
+}
+
+func (c *Camera) SetCOHNActive(ctx context.Context, active bool) error {
+	packets, err := proto.Action.SetCOHNActive(active)
+	return c.protoCommand(ctx, proto.FeatureCommand, proto.ActionSetCOHNSetting, packets, err)
+}
+
+// Wait until the camera is provisioned and connected to an access point, then gather everything needed to reach it over HTTPS.
+// The camera must already be connected to an access point, see ConnectAccessPoint. Waits up to WaitTimeout if the context has no deadline.
+func (c *Camera) GetCOHNCredentials(ctx context.Context) (cohn.Credentials, error) {
+
+	ctx, cancel := withDefaultTimeout(ctx, c.waitTimeout())
+	defer cancel()
+
+	statuses := make(chan *proto.NotifyCOHNStatus, 16)
+	stop := c.OnCOHNStatus(func(n *proto.NotifyCOHNStatus) {
+		select {
+		case statuses <- n:
+		default:
+		}
+	})
+	defer stop()
+
+	status, err := c.GetCOHNStatus(ctx, true)
+	if err != nil {
+		return cohn.Credentials{}, err
+	}
+	defer c.unregister(func(ctx context.Context) error {
+		_, err := c.GetCOHNStatus(ctx, false)
+		return err
+	})
+
+	for status.GetStatus() != proto.EnumCOHNStatus_COHN_PROVISIONED || status.GetState() != proto.EnumCOHNNetworkState_COHN_STATE_NetworkConnected || status.GetIpaddress() == "" {
+		select {
+		case status = <-statuses:
+		case <-ctx.Done():
+			return cohn.Credentials{}, fmt.Errorf("waiting for COHN to connect, currently %v and %v: %w", status.GetStatus(), status.GetState(), ctx.Err())
+		}
+	}
+
+	cert, err := c.GetCOHNCertificate(ctx)
+	if err != nil {
+		return cohn.Credentials{}, err
+	}
+
+	return cohn.Credentials{
+		Certificate: cert,
+		Username:    status.GetUsername(),
+		Password:    status.GetPassword(),
+		IPAddress:   status.GetIpaddress(),
+	}, nil
+
+}
+
+// Provision the camera for COHN from scratch, clearing any previous certificate, and return the credentials to reach it over HTTPS.
+// The camera must already be connected to an access point, see ConnectAccessPoint. Waits up to WaitTimeout if the context has no deadline.
+func (c *Camera) ProvisionCOHN(ctx context.Context) (cohn.Credentials, error) {
+
+	if err := c.ClearCOHNCertificate(ctx); err != nil {
+		return cohn.Credentials{}, fmt.Errorf("clearing certificate: %w", err)
+	}
+
+	if err := c.CreateCOHNCertificate(ctx, true); err != nil {
+		return cohn.Credentials{}, fmt.Errorf("creating certificate: %w", err)
+	}
+
+	return c.GetCOHNCredentials(ctx)
+
+}
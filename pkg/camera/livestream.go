@@ -0,0 +1,128 @@
+package camera
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thatpix3l/persephone/pkg/proto"
+)
+
+// Every livestream status the camera can notify about
+var liveStreamRegistrations = []proto.EnumRegisterLiveStreamStatus{
+	proto.EnumRegisterLiveStreamStatus_REGISTER_LIVE_STREAM_STATUS_STATUS,
+	proto.EnumRegisterLiveStreamStatus_REGISTER_LIVE_STREAM_STATUS_ERROR,
+	proto.EnumRegisterLiveStreamStatus_REGISTER_LIVE_STREAM_STATUS_MODE,
+	proto.EnumRegisterLiveStreamStatus_REGISTER_LIVE_STREAM_STATUS_BITRATE,
+}
+
+// Call "listener" with the livestream's status every time it changes, until the returned function is called.
+// Returns the status when subscribing.
+func (c *Camera) OnLiveStreamStatus(ctx context.Context, listener func(*proto.NotifyLiveStreamStatus)) (*proto.NotifyLiveStreamStatus, func(), error) {
+
+	stop := onProto(c, proto.FeatureQuery, proto.ActionNotifyLiveStreamStatus, "livestream status", listener)
+
+	status, err := c.GetLiveStreamStatus(ctx, liveStreamRegistrations, nil)
+	if err != nil {
+		stop()
+		return status, nil, err
+	}
+
+	return status, func() {
+		stop()
+		c.unregister(func(ctx context.Context) error {
+			_, err := c.GetLiveStreamStatus(ctx, nil, liveStreamRegistrations)
+			return err
+		})
+	}, nil
+
+}
+
+// Wait for the livestream to reach "want", after "trigger" asks the camera to move towards it.
+// Only statuses from after the trigger is answered count, as earlier ones may predate it, starting with the status read right after it.
+//
+// Errors if the livestream reports an error or fails before reaching "want", or the context is done first. Waits up to WaitTimeout if the context has no deadline.
+func (c *Camera) awaitLiveStream(ctx context.Context, want proto.EnumLiveStreamStatus, trigger func() error) (*proto.NotifyLiveStreamStatus, error) {
+
+	ctx, cancel := withDefaultTimeout(ctx, c.waitTimeout())
+	defer cancel()
+
+	triggered := make(chan struct{})
+	statuses := make(chan *proto.NotifyLiveStreamStatus, 16)
+	status, stop, err := c.OnLiveStreamStatus(ctx, func(n *proto.NotifyLiveStreamStatus) {
+		select {
+		case <-triggered:
+		default:
+			return
+		}
+		select {
+		case statuses <- n:
+		default:
+		}
+	})
+	if err != nil {
+		return status, err
+	}
+	defer stop()
+
+	if err := trigger(); err != nil {
+		return status, err
+	}
+	close(triggered)
+
+	// Notifications handled before the trigger was answered were dropped, so start from the current status
+	status, err = c.GetLiveStreamStatus(ctx, nil, nil)
+	if err != nil {
+		return status, err
+	}
+
+	for {
+
+		if err := status.Err(); err != nil {
+			return status, err
+		}
+
+		switch status.GetLiveStreamStatus() {
+		case want:
+			return status, nil
+		case proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_FAILED_STAY_ON, proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_UNAVAILABLE:
+			return status, proto.EnumLiveStreamError_LIVE_STREAM_ERROR_UNKNOWN
+		}
+
+		select {
+		case status = <-statuses:
+		case <-ctx.Done():
+			return status, fmt.Errorf("waiting for livestream to be %v, last %v: %w", want, status.GetLiveStreamStatus(), ctx.Err())
+		}
+
+	}
+
+}
+
+// Configure the livestream and start streaming, waiting until the camera reports it is streaming.
+// If the context has no deadline, each step waits up to WaitTimeout, as connecting to the server may take a while.
+//
+// Errors with a proto.EnumLiveStreamError if the camera fails to stream.
+func (c *Camera) StartLiveStream(ctx context.Context, config *proto.RequestSetLiveStreamMode) (*proto.NotifyLiveStreamStatus, error) {
+
+	status, err := c.awaitLiveStream(ctx, proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_READY, func() error {
+		return c.SetLiveStreamMode(ctx, config)
+	})
+	if err != nil {
+		return status, fmt.Errorf("configuring livestream: %w", err)
+	}
+
+	status, err = c.awaitLiveStream(ctx, proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_STREAMING, func() error {
+		return c.SetShutter(ctx, true)
+	})
+	if err != nil {
+		return status, fmt.Errorf("starting livestream: %w", err)
+	}
+
+	return status, nil
+
+}
+
+// Stop streaming, leaving the livestream configured so it can be started again with the shutter
+func (c *Camera) StopLiveStream(ctx context.Context) error {
+	return c.SetShutter(ctx, false)
+}
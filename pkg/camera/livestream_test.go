@@ -0,0 +1,138 @@
+package camera
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/packet"
+	"github.com/thatpix3l/persephone/pkg/proto"
+	"github.com/thatpix3l/persephone/pkg/transport"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Camera answering only the livestream's requests, pushing every status change right after the response causing it
+type liveStreamTransport struct {
+	mu           sync.Mutex
+	handlers     map[transport.Channel]transport.NotificationHandler
+	accumulators packet.Accumulators[transport.Channel]
+	status       proto.EnumLiveStreamStatus
+}
+
+func (t *liveStreamTransport) Subscribe(channel transport.Channel, handler transport.NotificationHandler) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.handlers == nil {
+		t.handlers = map[transport.Channel]transport.NotificationHandler{}
+	}
+	t.handlers[channel] = handler
+	return nil
+}
+
+func (t *liveStreamTransport) Close() error {
+	return nil
+}
+
+func (t *liveStreamTransport) send(channel transport.Channel, message []byte) {
+	packets, err := packet.Frame(message)
+	if err != nil {
+		panic(err)
+	}
+	t.mu.Lock()
+	handler := t.handlers[channel]
+	t.mu.Unlock()
+	for _, p := range packets {
+		handler(p)
+	}
+}
+
+func (t *liveStreamTransport) sendProto(channel transport.Channel, feature byte, action byte, m protobuf.Message) {
+	payload, err := protobuf.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	t.send(channel, append([]byte{feature, action}, payload...))
+}
+
+func (t *liveStreamTransport) notify(status proto.EnumLiveStreamStatus) {
+	t.mu.Lock()
+	t.status = status
+	t.mu.Unlock()
+	t.sendProto(transport.Query, proto.FeatureQuery, proto.ActionNotifyLiveStreamStatus, &proto.NotifyLiveStreamStatus{LiveStreamStatus: status.Enum()})
+}
+
+func (t *liveStreamTransport) Write(channel transport.Channel, packets [][]byte) error {
+
+	var message []byte
+	for _, p := range packets {
+		m, err := t.accumulators.Accumulate(channel, p)
+		if err != nil {
+			return err
+		}
+		message = m
+	}
+
+	success := &proto.ResponseGeneric{Result: proto.EnumResultGeneric_RESULT_SUCCESS.Enum()}
+
+	switch {
+
+	case channel == transport.Command && message[0] == proto.FeatureCommand && message[1] == proto.ActionSetLiveStreamMode:
+		t.sendProto(channel, proto.FeatureCommand, proto.ResponseAction(proto.ActionSetLiveStreamMode), success)
+		t.notify(proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_READY)
+
+	case channel == transport.Command && message[0] == 0x01:
+		t.send(channel, []byte{0x01, 0x00})
+		if message[2] == 1 {
+			t.notify(proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_STREAMING)
+		}
+
+	case channel == transport.Query && message[0] == proto.FeatureQuery && message[1] == proto.ActionGetLiveStreamStatus:
+		t.mu.Lock()
+		status := t.status
+		t.mu.Unlock()
+		t.sendProto(channel, proto.FeatureQuery, proto.ResponseAction(proto.ActionGetLiveStreamStatus), &proto.NotifyLiveStreamStatus{LiveStreamStatus: status.Enum()})
+
+	}
+
+	return nil
+
+}
+
+func TestStartLiveStream(t *testing.T) {
+
+	tr := &liveStreamTransport{status: proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_IDLE}
+	c, err := New(tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.WaitTimeout = time.Second
+
+	// Every status is pushed right after the response causing it, before the wait for it begins
+	status, err := c.StartLiveStream(context.Background(), &proto.RequestSetLiveStreamMode{Url: protobuf.String("rtmp://example.com/live"), Encode: protobuf.Bool(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.GetLiveStreamStatus() != proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_STREAMING {
+		t.Errorf("got status %v, want streaming", status.GetLiveStreamStatus())
+	}
+
+}
+
+func TestAwaitLiveStreamTimeout(t *testing.T) {
+
+	tr := &liveStreamTransport{status: proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_IDLE}
+	c, err := New(tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.WaitTimeout = 20 * time.Millisecond
+
+	// Never reaches streaming, so only the default timeout ends the wait
+	_, err = c.awaitLiveStream(context.Background(), proto.EnumLiveStreamStatus_LIVE_STREAM_STATE_STREAMING, func() error { return nil })
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+}
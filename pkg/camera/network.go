@@ -0,0 +1,167 @@
+package camera
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/proto"
+)
+
+// Count of access points requested per page by ListAccessPoints
+const accessPointPageSize = 10
+
+// Scan for access points, waiting until the scan completes. The returned notification identifies the scan's results, see ListAccessPoints.
+// Scans take several seconds and the camera may never report completion, so waits up to WaitTimeout if the context has no deadline.
+func (c *Camera) ScanAccessPoints(ctx context.Context) (*proto.NotifStartScanning, error) {
+
+	ctx, cancel := withDefaultTimeout(ctx, c.waitTimeout())
+	defer cancel()
+
+	notifications := make(chan *proto.NotifStartScanning, 16)
+	stop := onProto(c, proto.FeatureNetworkManagement, proto.ActionNotifyStartScanning, "scan", func(n *proto.NotifStartScanning) {
+		select {
+		case notifications <- n:
+		default:
+		}
+	})
+	defer stop()
+
+	r := &proto.ResponseStartScanning{}
+	packets, err := proto.Action.StartScan()
+	if err := c.protoRequest(ctx, proto.FeatureNetworkManagement, proto.ActionStartScan, packets, err, r); err != nil {
+		return nil, err
+	}
+	if err := r.GetResult().Err(); err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+
+		case n := <-notifications:
+			switch n.GetScanningState() {
+			case proto.EnumScanning_SCANNING_SUCCESS:
+				return n, nil
+			case proto.EnumScanning_SCANNING_ABORTED_BY_SYSTEM, proto.EnumScanning_SCANNING_CANCELLED_BY_USER:
+				return n, fmt.Errorf("scan ended early: %v", n.GetScanningState())
+			}
+
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for scan to complete: %w", ctx.Err())
+
+		}
+	}
+
+}
+
+// Get up to "max" access points found by the scan "scanID", starting at index "start"
+func (c *Camera) GetAccessPointEntries(ctx context.Context, scanID int32, start int32, max int32) ([]*proto.ResponseGetApEntries_ScanEntry, error) {
+
+	r := &proto.ResponseGetApEntries{}
+	packets, err := proto.Action.GetAccessPointEntries(scanID, start, max)
+	if err := c.protoRequest(ctx, proto.FeatureNetworkManagement, proto.ActionGetApEntries, packets, err, r); err != nil {
+		return nil, err
+	}
+
+	return r.GetEntries(), r.GetResult().Err()
+
+}
+
+// Get every access point found by "scan", paging through them
+func (c *Camera) ListAccessPoints(ctx context.Context, scan *proto.NotifStartScanning) ([]*proto.ResponseGetApEntries_ScanEntry, error) {
+
+	entries := []*proto.ResponseGetApEntries_ScanEntry{}
+	for start := int32(0); start < scan.GetTotalEntries(); start += accessPointPageSize {
+
+		page, err := c.GetAccessPointEntries(ctx, scan.GetScanId(), start, accessPointPageSize)
+		if err != nil {
+			return entries, err
+		}
+		if len(page) == 0 {
+			break
+		}
+
+		entries = append(entries, page...)
+
+	}
+
+	return entries, nil
+
+}
+
+// Call "listener" with every provisioning state the camera pushes while connecting to an access point, until the returned function is called
+func (c *Camera) OnProvisioningState(listener func(proto.EnumProvisioning)) func() {
+	return onProto(c, proto.FeatureNetworkManagement, proto.ActionNotifyProvisioningState, "provisioning", func(n *proto.NotifProvisioningState) {
+		listener(n.GetProvisioningState())
+	})
+}
+
+// Send a connect request for "action", waiting until the camera either connects or fails to.
+// ResponseConnect and ResponseConnectNew share their fields, so either decodes as the other.
+func (c *Camera) connect(ctx context.Context, action byte, packets [][]byte, err error) (proto.EnumProvisioning, error) {
+
+	if err != nil {
+		return proto.EnumProvisioning_PROVISIONING_UNKNOWN, err
+	}
+
+	states := make(chan proto.EnumProvisioning, 16)
+	stop := c.OnProvisioningState(func(state proto.EnumProvisioning) {
+		select {
+		case states <- state:
+		default:
+		}
+	})
+	defer stop()
+
+	r := &proto.ResponseConnect{}
+	if err := c.protoRequest(ctx, proto.FeatureNetworkManagement, action, packets, nil, r); err != nil {
+		return proto.EnumProvisioning_PROVISIONING_UNKNOWN, err
+	}
+	if err := r.GetResult().Err(); err != nil {
+		return r.GetProvisioningState(), err
+	}
+
+	// The camera gives up on its own after the timeout it answers with, allow a little longer for its final notification
+	var expired <-chan time.Time
+	timeout := r.GetTimeoutSeconds()
+	if timeout > 0 {
+		timer := time.NewTimer(time.Duration(timeout)*time.Second + c.timeout())
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	state := r.GetProvisioningState()
+	for !state.Done() {
+		select {
+		case state = <-states:
+		case <-expired:
+			return state, fmt.Errorf("camera did not finish connecting within %ds", timeout)
+		case <-ctx.Done():
+			return state, fmt.Errorf("waiting for connection, camera gave itself %ds: %w", timeout, ctx.Err())
+		}
+	}
+
+	return state, state.Err()
+
+}
+
+// Connect to an access point the camera already knows, such as one flagged proto.EnumScanEntryFlags_SCAN_FLAG_CONFIGURED, waiting until it connects.
+//
+// Errors with a *proto.ProvisioningError if the camera fails to connect.
+func (c *Camera) ConnectAccessPoint(ctx context.Context, ssid string) (proto.EnumProvisioning, error) {
+
+	packets, err := proto.Action.Connect(ssid)
+	return c.connect(ctx, proto.ActionConnect, packets, err)
+
+}
+
+// Connect to an access point the camera does not know yet, waiting until it connects. The camera remembers the access point afterwards.
+//
+// Errors with a *proto.ProvisioningError if the camera fails to connect.
+func (c *Camera) ConnectNewAccessPoint(ctx context.Context, r *proto.RequestConnectNew) (proto.EnumProvisioning, error) {
+
+	packets, err := proto.Action.ConnectNew(r)
+	return c.connect(ctx, proto.ActionConnectNew, packets, err)
+
+}
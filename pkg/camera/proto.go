@@ -0,0 +1,143 @@
+package camera
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thatpix3l/persephone/pkg/proto"
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/transport"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Return the channel protobuf messages of "feature" are written to
+func featureChannel(feature byte) transport.Channel {
+	switch feature {
+	case proto.FeatureQuery:
+		return transport.Query
+	case proto.FeatureNetworkManagement:
+		return transport.NetworkManagement
+	}
+	return transport.Command
+}
+
+// Send a protobuf request for "action" of "feature", as built by proto.Action, decoding its response into "m"
+func (c *Camera) protoRequest(ctx context.Context, feature byte, action byte, packets [][]byte, err error, m protobuf.Message) error {
+
+	if err != nil {
+		return err
+	}
+
+	message, err := c.Request(ctx, featureChannel(feature), packets)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(message, feature, proto.ResponseAction(action), m)
+
+}
+
+// Send a protobuf request answered with ResponseGeneric, returning an error unless it succeeded
+func (c *Camera) protoCommand(ctx context.Context, feature byte, action byte, packets [][]byte, err error) error {
+
+	r := &proto.ResponseGeneric{}
+	if err := c.protoRequest(ctx, feature, action, packets, err, r); err != nil {
+		return err
+	}
+
+	return r.GetResult().Err()
+
+}
+
+// Call "listener" with every protobuf notification with "feature" and "action" IDs, decoded into a new message, until the returned function is called.
+// Notifications that fail to decode are reported through Errors, described by "name".
+func onProto[T any, M interface {
+	*T
+	protobuf.Message
+}](c *Camera, feature byte, action byte, name string, listener func(M)) func() {
+	return c.OnNotification(feature, action, func(payload []byte) {
+		m := M(new(T))
+		if err := protobuf.Unmarshal(payload, m); err != nil {
+			c.reportError(fmt.Errorf("%s notification: %w", name, err))
+			return
+		}
+		listener(m)
+	})
+}
+
+func (c *Camera) SetCameraControlStatus(ctx context.Context, status query.CameraControlStatus) error {
+	packets, err := proto.Action.SetCameraControlStatus(status)
+	return c.protoCommand(ctx, proto.FeatureCommand, proto.ActionSetCameraControlStatus, packets, err)
+}
+
+func (c *Camera) SetTurboTransfer(ctx context.Context, active bool) error {
+	packets, err := proto.Action.SetTurboTransfer(active)
+	return c.protoCommand(ctx, proto.FeatureCommand, proto.ActionSetTurboActive, packets, err)
+}
+
+func (c *Camera) GetLastCapturedMedia(ctx context.Context) (*proto.Media, error) {
+
+	r := &proto.ResponseLastCapturedMedia{}
+	packets, err := proto.Action.GetLastCapturedMedia()
+	if err := c.protoRequest(ctx, proto.FeatureQuery, proto.ActionGetLastCapturedMedia, packets, err, r); err != nil {
+		return nil, err
+	}
+
+	return r.GetMedia(), r.GetResult().Err()
+
+}
+
+// Get every available preset, optionally (un)registering for notifications when they change
+func (c *Camera) GetPresetStatus(ctx context.Context, register []proto.EnumRegisterPresetStatus, unregister []proto.EnumRegisterPresetStatus) (*proto.NotifyPresetStatus, error) {
+	r := &proto.NotifyPresetStatus{}
+	packets, err := proto.Action.GetPresetStatus(register, unregister)
+	err = c.protoRequest(ctx, proto.FeatureQuery, proto.ActionGetPresetStatus, packets, err, r)
+	return r, err
+}
+
+// Update the title and icon of the active custom preset.
+// Creating and deleting custom presets is left out, as the camera only allows either from its own UI.
+func (c *Camera) UpdateCustomPreset(ctx context.Context, r *proto.RequestCustomPresetUpdate) error {
+	packets, err := proto.Action.UpdateCustomPreset(r)
+	return c.protoCommand(ctx, proto.FeatureCommand, proto.ActionUpdateCustomPreset, packets, err)
+}
+
+// Call "listener" with the available presets every time a preset is modified, added or removed, until the returned function is called.
+// Returns the presets available when subscribing.
+func (c *Camera) OnPresetStatus(ctx context.Context, listener func(*proto.NotifyPresetStatus)) (*proto.NotifyPresetStatus, func(), error) {
+
+	stop := onProto(c, proto.FeatureQuery, proto.ActionNotifyPresetStatus, "preset status", listener)
+
+	registrations := []proto.EnumRegisterPresetStatus{
+		proto.EnumRegisterPresetStatus_REGISTER_PRESET_STATUS_PRESET,
+		proto.EnumRegisterPresetStatus_REGISTER_PRESET_STATUS_PRESET_GROUP_ARRAY,
+	}
+
+	presets, err := c.GetPresetStatus(ctx, registrations, nil)
+	if err != nil {
+		stop()
+		return presets, nil, err
+	}
+
+	return presets, func() {
+		stop()
+		c.unregister(func(ctx context.Context) error {
+			_, err := c.GetPresetStatus(ctx, nil, registrations)
+			return err
+		})
+	}, nil
+
+}
+
+func (c *Camera) SetLiveStreamMode(ctx context.Context, r *proto.RequestSetLiveStreamMode) error {
+	packets, err := proto.Action.SetLiveStreamMode(r)
+	return c.protoCommand(ctx, proto.FeatureCommand, proto.ActionSetLiveStreamMode, packets, err)
+}
+
+// Get the livestream's status, optionally (un)registering for notifications when it changes
+func (c *Camera) GetLiveStreamStatus(ctx context.Context, register []proto.EnumRegisterLiveStreamStatus, unregister []proto.EnumRegisterLiveStreamStatus) (*proto.NotifyLiveStreamStatus, error) {
+	r := &proto.NotifyLiveStreamStatus{}
+	packets, err := proto.Action.GetLiveStreamStatus(register, unregister)
+	err = c.protoRequest(ctx, proto.FeatureQuery, proto.ActionGetLiveStreamStatus, packets, err, r)
+	return r, err
+}
@@ -0,0 +1,66 @@
+// Client for controlling a camera provisioned for Camera on the Home Network (COHN), over HTTPS on the local network rather than BLE
+package cohn
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Everything needed to reach a provisioned camera, as gathered over BLE
+type Credentials struct {
+	Certificate []byte // PEM encoded root CA the camera's HTTPS certificate is signed by
+	Username    string
+	Password    string
+	IPAddress   string
+}
+
+// Return the base URL of the camera's HTTPS API
+func (c Credentials) URL() *url.URL {
+	return &url.URL{Scheme: "https", Host: c.IPAddress}
+}
+
+// Return an HTTP client that trusts only the camera's certificate and authenticates every request with its credentials
+func (c Credentials) Client() (*http.Client, error) {
+
+	if c.IPAddress == "" {
+		return nil, errors.New("camera has no IP address, it is not connected to an access point")
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(c.Certificate) {
+		return nil, errors.New("certificate contains no PEM encoded certificates")
+	}
+
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &basicAuth{
+			username: c.Username,
+			password: c.Password,
+			base: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs:    pool,
+					MinVersion: tls.VersionTLS12,
+				},
+			},
+		},
+	}, nil
+
+}
+
+// Adds basic authentication to every request before handing it to "base"
+type basicAuth struct {
+	username string
+	password string
+	base     http.RoundTripper
+}
+
+func (b *basicAuth) RoundTrip(r *http.Request) (*http.Response, error) {
+	// Round trippers must not modify the request they are given
+	r = r.Clone(r.Context())
+	r.SetBasicAuth(b.username, b.password)
+	return b.base.RoundTrip(r)
+}
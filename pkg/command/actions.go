@@ -4,6 +4,8 @@ package command
 import (
 	"encoding/binary"
 	"time"
+
+	"github.com/thatpix3l/persephone/pkg/packet"
 )
 
 const (
@@ -20,34 +22,32 @@ func boolToUint8(b bool) uint8 {
 	}
 }
 
-func buildAction(id byte, parameters ...byte) []byte {
+// Build the message for command "id" with optional "parameters", framed into packets ready to be written to the command characteristic
+func buildAction(id byte, parameters ...byte) ([][]byte, error) {
 
-	finalPacket := []byte{1, id}
-	if len(parameters) == 0 {
-		return finalPacket
+	message := []byte{id}
+	if len(parameters) > 0 {
+		message = append(message, byte(len(parameters)))
+		message = append(message, parameters...)
 	}
 
-	finalPacket = append(finalPacket, byte(len(parameters)))
-	finalPacket = append(finalPacket, parameters...)
-	finalPacket[0] = finalPacket[2] + 2
-
-	return finalPacket
+	return packet.Frame(message)
 
 }
 
-func (a actionT) TurnShutterOn() []byte {
+func (a actionT) TurnShutterOn() ([][]byte, error) {
 	return buildAction(0x01, 0x01)
 }
 
-func (a actionT) TurnShutterOff() []byte {
+func (a actionT) TurnShutterOff() ([][]byte, error) {
 	return buildAction(0x01, 0x00)
 }
 
-func (a actionT) Sleep() []byte {
+func (a actionT) Sleep() ([][]byte, error) {
 	return buildAction(0x05)
 }
 
-func (a actionT) SetDateTime(t time.Time) []byte {
+func (a actionT) SetDateTime(t time.Time) ([][]byte, error) {
 
 	dateBuf := []byte{}
 
@@ -65,11 +65,11 @@ func (a actionT) SetDateTime(t time.Time) []byte {
 
 }
 
-func (a actionT) GetDateTime() []byte {
+func (a actionT) GetDateTime() ([][]byte, error) {
 	return buildAction(0x0e)
 }
 
-func (a actionT) SetLocalDateTime(t time.Time) []byte {
+func (a actionT) SetLocalDateTime(t time.Time) ([][]byte, error) {
 
 	dateBuf := []byte{}
 
@@ -106,42 +106,56 @@ func (a actionT) SetLocalDateTime(t time.Time) []byte {
 
 }
 
-func (a actionT) GetLocalDateTime() []byte {
+func (a actionT) GetLocalDateTime() ([][]byte, error) {
 	return buildAction(0x10)
 }
 
-func (a actionT) TurnAccessPointOff() []byte {
+func (a actionT) TurnAccessPointOff() ([][]byte, error) {
 	return buildAction(0x17, 0x00)
 }
 
-func (a actionT) TurnAccessPointOn() []byte {
+func (a actionT) TurnAccessPointOn() ([][]byte, error) {
 	return buildAction(0x17, 0x01)
 }
 
-func (a actionT) HilightMoment() []byte {
+func (a actionT) HilightMoment() ([][]byte, error) {
 	return buildAction(0x18)
 }
 
-func (a actionT) GetHardwareInfo() []byte {
+func (a actionT) GetHardwareInfo() ([][]byte, error) {
 	return buildAction(0x3c)
 }
 
-func (a actionT) LoadPresetGroupVideo() []byte {
-	return buildAction(0x3e, 0x03, 0xe8)
+// Load the preset group "id", e.g. 1000 for video, as listed by the preset status
+func (a actionT) LoadPresetGroup(id uint16) ([][]byte, error) {
+	idBuf := make([]byte, 2)
+	binary.BigEndian.PutUint16(idBuf, id)
+	return buildAction(0x3e, idBuf...)
+}
+
+func (a actionT) LoadPresetGroupVideo() ([][]byte, error) {
+	return a.LoadPresetGroup(1000)
+}
+
+func (a actionT) LoadPresetGroupPhoto() ([][]byte, error) {
+	return a.LoadPresetGroup(1001)
 }
 
-func (a actionT) LoadPresetGroupPhoto() []byte {
-	return buildAction(0x3e, 0x03, 0xe9)
+func (a actionT) LoadPresetGroupTimelapse() ([][]byte, error) {
+	return a.LoadPresetGroup(1002)
 }
 
-func (a actionT) LoadPresetGroupTimelapse() []byte {
-	return buildAction(0x3e, 0x03, 0xea)
+// Load the preset "id", as listed by the preset status
+func (a actionT) LoadPreset(id uint32) ([][]byte, error) {
+	idBuf := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBuf, id)
+	return buildAction(0x40, idBuf...)
 }
 
-func (a actionT) Analytics() []byte {
+func (a actionT) Analytics() ([][]byte, error) {
 	return buildAction(0x50)
 }
 
-func (a actionT) GetVersion() []byte {
+func (a actionT) GetVersion() ([][]byte, error) {
 	return buildAction(0x51)
 }
@@ -11,18 +11,19 @@ import (
 )
 
 // Semantic versioning
-type semVer struct {
+type SemVer struct {
 	Major int
 	Minor int
 	Patch int
 }
 
 // Return a string in the form of vX.Y.Z, where X, Y and Z corresponds to the major, minor and patch version, respectively
-func (s *semVer) String() string {
+func (s *SemVer) String() string {
 	return fmt.Sprintf("v%d.%d.%d", s.Major, s.Minor, s.Patch)
 }
 
-type hardware struct {
+// Identity of the camera, as reported by GetHardwareInfo
+type Hardware struct {
 	ModelNumber     string
 	ModelName       string
 	Board           string
@@ -32,7 +33,7 @@ type hardware struct {
 	SSIDMacAddress  string
 }
 
-type response struct {
+type Response struct {
 	Shutter           bool      // `commandID:"1"`
 	Sleep             bool      // `commandID:"5"`
 	SetDateTime       bool      // `commandID:"13"`
@@ -42,15 +43,15 @@ type response struct {
 	SetLivestreamMode bool      // `commandID:"21"`
 	WifiAP            bool      // `commandID:"23"`
 	HiLightMoment     bool      // `commandID:"24"`
-	Hardware          hardware  // `commandID:"60"`
+	Hardware          Hardware  // `commandID:"60"`
 	LoadPresetGroup   bool      // `commandID:"62"`
 	LoadPreset        bool      // `commandID:"64"`
 	Analytics         bool      // `commandID:"80"`
-	OpenGoProVersion  semVer    // `commandID:"81"`
+	OpenGoProVersion  SemVer    // `commandID:"81"`
 }
 
-func NewResponse() response {
-	return response{}
+func NewResponse() Response {
+	return Response{}
 }
 
 // Return true or false if "i" is 1 or 0, error if any other number
@@ -78,26 +79,27 @@ func bytesToHexString(buf []byte) string {
 	return hexString
 }
 
-// Unmarshal a byte slice into *response.
+// Unmarshal a complete response message, as reassembled by packet.Accumulator, into *Response.
 //
-// Errors if not minimum length, length does not match expected length, or has unknown status ID.
-func (r *response) Unmarshal(data []byte) error {
+// Errors if not minimum length, or has unknown status ID.
+func (r *Response) Unmarshal(data []byte) error {
 
-	if len(data) < 3 {
-		return fmt.Errorf("data length is less than minimum of 3: %v", data)
+	if len(data) < 2 {
+		return fmt.Errorf("data length is less than minimum of 2: %v", data)
 	}
 
-	suggestedPacketLength := data[0]
-	if len(data)-1 != int(suggestedPacketLength) {
-		return fmt.Errorf("packet length (index 0) does not match suggested packet length: %v", data)
-	}
-
-	id := data[1]
-	successCode := data[2]
-	valueBuf := data[3:]
+	id := data[0]
+	successCode := data[1]
+	valueBuf := data[2:]
 
-	shiftValueBuf := func() {
+	// Return the next length prefixed value of "valueBuf" and move past it, erroring if it is cut short
+	nextValue := func() ([]byte, error) {
+		if len(valueBuf) < 1 || len(valueBuf) < int(valueBuf[0])+1 {
+			return nil, fmt.Errorf("length prefixed value is truncated: %v", valueBuf)
+		}
+		value := valueBuf[1 : valueBuf[0]+1]
 		valueBuf = valueBuf[valueBuf[0]+1:]
+		return value, nil
 	}
 
 	var funcError error = nil
@@ -114,8 +116,12 @@ func (r *response) Unmarshal(data []byte) error {
 		r.SetDateTime, funcError = intToBool(successCode)
 
 	case 0x0e:
+		if len(valueBuf) < 8 {
+			funcError = fmt.Errorf("date time value is shorter than minimum of 8: %v", valueBuf)
+			break
+		}
 		r.DateTime = time.Date(
-			int(binary.BigEndian.Uint16(valueBuf[1:2])), // Two bytes for year
+			int(binary.BigEndian.Uint16(valueBuf[1:3])), // Two bytes for year
 			time.Month(valueBuf[3]),                     // Byte for current month
 			int(valueBuf[4]),                            // day
 			int(valueBuf[5]),                            // hour
@@ -150,20 +156,23 @@ func (r *response) Unmarshal(data []byte) error {
 		r.HiLightMoment, funcError = intToBool(successCode)
 
 	case 0x3c:
-		r.Hardware.ModelNumber = fmt.Sprintf("%x:%x:%x:%x", valueBuf[1], valueBuf[2], valueBuf[3], valueBuf[4])
-		shiftValueBuf()
-		r.Hardware.ModelName = string(valueBuf[1 : valueBuf[0]+1])
-		shiftValueBuf()
-		r.Hardware.Board = string(valueBuf[1 : valueBuf[0]+1])
-		shiftValueBuf()
-		r.Hardware.FirmwareVersion = string(valueBuf[1 : valueBuf[0]+1])
-		shiftValueBuf()
-		r.Hardware.SerialNumber = string(valueBuf[1 : valueBuf[0]+1])
-		shiftValueBuf()
-		r.Hardware.SSID = string(valueBuf[1 : valueBuf[0]+1])
-		shiftValueBuf()
-		r.Hardware.SSIDMacAddress = bytesToHexString(valueBuf[1 : valueBuf[0]+1])
-		shiftValueBuf()
+		// Model number, model name, board, firmware version, serial number, SSID and MAC address, each length prefixed
+		var values [7][]byte
+		for i := range values {
+			if values[i], funcError = nextValue(); funcError != nil {
+				break
+			}
+		}
+		if funcError != nil {
+			break
+		}
+		r.Hardware.ModelNumber = bytesToHexString(values[0])
+		r.Hardware.ModelName = string(values[1])
+		r.Hardware.Board = string(values[2])
+		r.Hardware.FirmwareVersion = string(values[3])
+		r.Hardware.SerialNumber = string(values[4])
+		r.Hardware.SSID = string(values[5])
+		r.Hardware.SSIDMacAddress = bytesToHexString(values[6])
 
 	case 0x3e:
 		r.LoadPresetGroup, funcError = intToBool(successCode)
@@ -175,8 +184,19 @@ func (r *response) Unmarshal(data []byte) error {
 		r.Analytics, funcError = intToBool(successCode)
 
 	case 0x51:
-		r.OpenGoProVersion.Major = int(valueBuf[1])
-		r.OpenGoProVersion.Minor = int(valueBuf[3])
+		// Major and minor version, each length prefixed
+		major, err := nextValue()
+		if err != nil || len(major) != 1 {
+			funcError = fmt.Errorf("major version is malformed: %v", data[2:])
+			break
+		}
+		minor, err := nextValue()
+		if err != nil || len(minor) != 1 {
+			funcError = fmt.Errorf("minor version is malformed: %v", data[2:])
+			break
+		}
+		r.OpenGoProVersion.Major = int(major[0])
+		r.OpenGoProVersion.Minor = int(minor[0])
 
 	default:
 		funcError = fmt.Errorf("status id does not exist: %v (%x)", id, id)
@@ -0,0 +1,39 @@
+package command
+
+import "testing"
+
+func TestUnmarshalShortReplies(t *testing.T) {
+
+	tests := [][]byte{
+		{0x3c, 0},
+		{0x3c, 0, 4, 1, 2},
+		{0x3c, 0, 4, 1, 2, 3, 4, 5, 'H', 'E', 'R', 'O'},
+		{0x51, 0},
+		{0x51, 0, 1, 2},
+		{0x51, 0, 1, 2, 1},
+	}
+
+	for _, data := range tests {
+		r := NewResponse()
+		if err := r.Unmarshal(data); err == nil {
+			t.Errorf("short reply %v did not error", data)
+		}
+	}
+
+}
+
+func TestUnmarshalHardwareInfo(t *testing.T) {
+
+	data := []byte{0x3c, 0, 4, 0, 0, 0, 0x3e, 4, 'H', 'E', 'R', 'O', 1, 'b', 2, '1', '2', 1, 's', 2, 'G', 'P', 6, 1, 2, 3, 4, 5, 6}
+
+	r := NewResponse()
+	if err := r.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Hardware{ModelNumber: "0:0:0:3e", ModelName: "HERO", Board: "b", FirmwareVersion: "12", SerialNumber: "s", SSID: "GP", SSIDMacAddress: "1:2:3:4:5:6"}
+	if r.Hardware != want {
+		t.Errorf("got %+v, want %+v", r.Hardware, want)
+	}
+
+}
@@ -0,0 +1,35 @@
+// Common interface over every way of controlling a camera, so apps can switch between BLE and HTTP without changing their logic
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/command"
+	"github.com/thatpix3l/persephone/pkg/proto"
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/settings"
+)
+
+// Operations supported by both camera.Camera over BLE and http.Client over Wi-Fi, USB or the home network
+type Controller interface {
+	SetShutter(ctx context.Context, on bool) error
+
+	// Apply a setting, e.g. SetSetting(ctx, settings.IDVideoResolution, byte(settings.Res4K))
+	SetSetting(ctx context.Context, id settings.ID, value byte) error
+
+	// Get every status and setting value, decoding them into "statuses" and "s", either of which may be nil to skip them
+	GetState(ctx context.Context, statuses *query.Response, s *settings.Response) (query.Report, settings.Report, error)
+
+	SetDateTime(ctx context.Context, t time.Time) error
+	GetDateTime(ctx context.Context) (time.Time, error)
+
+	GetVersion(ctx context.Context) (command.SemVer, error)
+	GetHardwareInfo(ctx context.Context) (command.Hardware, error)
+
+	LoadPreset(ctx context.Context, id int32) error
+	LoadPresetGroup(ctx context.Context, id proto.EnumPresetGroup) error
+
+	SetCameraControlStatus(ctx context.Context, status query.CameraControlStatus) error
+	SetTurboTransfer(ctx context.Context, active bool) error
+}
@@ -0,0 +1,230 @@
+package http
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/command"
+	"github.com/thatpix3l/persephone/pkg/proto"
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/settings"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (c *Client) SetShutter(ctx context.Context, on bool) error {
+	if on {
+		return c.get(ctx, "/gopro/camera/shutter/start", nil)
+	}
+	return c.get(ctx, "/gopro/camera/shutter/stop", nil)
+}
+
+// Keep the camera from going to sleep, which it otherwise does a few seconds after the last request. Should be sent every few seconds.
+func (c *Client) KeepAlive(ctx context.Context) error {
+	return c.get(ctx, "/gopro/camera/keep_alive", nil)
+}
+
+// Get every available preset, grouped as on the camera's UI
+func (c *Client) GetPresetStatus(ctx context.Context) (*proto.NotifyPresetStatus, error) {
+
+	var raw json.RawMessage
+	if err := c.getJSON(ctx, "/gopro/camera/presets/get", nil, &raw); err != nil {
+		return nil, err
+	}
+
+	// The camera answers with the protobuf message's JSON mapping, possibly with fields newer than these definitions
+	r := &proto.NotifyPresetStatus{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, r); err != nil {
+		return nil, fmt.Errorf("/gopro/camera/presets/get: decoding response: %w", err)
+	}
+
+	return r, nil
+
+}
+
+// Load the preset "id", as listed by GetPresetStatus
+func (c *Client) LoadPreset(ctx context.Context, id int32) error {
+	return c.get(ctx, "/gopro/camera/presets/load", url.Values{"id": {strconv.Itoa(int(id))}})
+}
+
+func (c *Client) LoadPresetGroup(ctx context.Context, id proto.EnumPresetGroup) error {
+	return c.get(ctx, "/gopro/camera/presets/set_group", url.Values{"id": {strconv.Itoa(int(id))}})
+}
+
+// Apply a setting, e.g. SetSetting(ctx, settings.IDVideoResolution, byte(settings.Res4K))
+func (c *Client) SetSetting(ctx context.Context, id settings.ID, value byte) error {
+	return c.get(ctx, "/gopro/camera/setting", url.Values{
+		"setting": {strconv.Itoa(int(id))},
+		"option":  {strconv.Itoa(int(value))},
+	})
+}
+
+// Tell the camera who is in control of it, e.g. external control to keep its UI from interfering
+func (c *Client) SetCameraControlStatus(ctx context.Context, status query.CameraControlStatus) error {
+	return c.get(ctx, "/gopro/camera/control/set_ui_controller", url.Values{"p": {strconv.Itoa(int(status))}})
+}
+
+func (c *Client) SetTurboTransfer(ctx context.Context, active bool) error {
+	return c.get(ctx, "/gopro/media/turbo_transfer", url.Values{"p": {boolToParam(active)}})
+}
+
+// Set the camera's date and time, including its time zone and daylight saving time
+func (c *Client) SetDateTime(ctx context.Context, t time.Time) error {
+
+	_, offset := t.Zone()
+	dst := t.IsDST()
+	if dst {
+		// The camera applies the daylight saving hour on top of the offset itself
+		offset -= int(time.Hour / time.Second)
+	}
+
+	return c.get(ctx, "/gopro/camera/set_date_time", url.Values{
+		"date":  {fmt.Sprintf("%d_%d_%d", t.Year(), t.Month(), t.Day())},
+		"time":  {fmt.Sprintf("%d_%d_%d", t.Hour(), t.Minute(), t.Second())},
+		"tzone": {strconv.Itoa(offset / 60)},
+		"dst":   {boolToParam(dst)},
+	})
+
+}
+
+// Parse "s" of the form "A_B_C" into its three numbers
+func parseTriple(s string) ([3]int, error) {
+
+	var triple [3]int
+
+	parts := strings.Split(s, "_")
+	if len(parts) != 3 {
+		return triple, fmt.Errorf("%q does not have 3 parts", s)
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return triple, fmt.Errorf("%q: %w", s, err)
+		}
+		triple[i] = n
+	}
+
+	return triple, nil
+
+}
+
+// Get the camera's date and time, in its own time zone
+func (c *Client) GetDateTime(ctx context.Context) (time.Time, error) {
+
+	var r struct {
+		Date  string `json:"date"`
+		Time  string `json:"time"`
+		TZone int    `json:"tzone"`
+		DST   int    `json:"dst"`
+	}
+	if err := c.getJSON(ctx, "/gopro/camera/get_date_time", nil, &r); err != nil {
+		return time.Time{}, err
+	}
+
+	date, err := parseTriple(r.Date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing date: %w", err)
+	}
+
+	clock, err := parseTriple(r.Time)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing time: %w", err)
+	}
+
+	offset := r.TZone * 60
+	if r.DST != 0 {
+		offset += int(time.Hour / time.Second)
+	}
+	zone := time.FixedZone("", offset)
+
+	return time.Date(date[0], time.Month(date[1]), date[2], clock[0], clock[1], clock[2], 0, zone), nil
+
+}
+
+// Get the version of the Open GoPro API the camera implements
+func (c *Client) GetVersion(ctx context.Context) (command.SemVer, error) {
+
+	var r struct {
+		Version string `json:"version"`
+	}
+	if err := c.getJSON(ctx, "/gopro/version", nil, &r); err != nil {
+		return command.SemVer{}, err
+	}
+
+	var v command.SemVer
+	parts := strings.Split(r.Version, ".")
+	for i, field := range []*int{&v.Major, &v.Minor, &v.Patch} {
+		if i >= len(parts) {
+			break
+		}
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return v, fmt.Errorf("parsing version %q: %w", r.Version, err)
+		}
+		*field = n
+	}
+
+	return v, nil
+
+}
+
+func (c *Client) GetHardwareInfo(ctx context.Context) (command.Hardware, error) {
+
+	var r struct {
+		Info struct {
+			ModelNumber     int    `json:"model_number"`
+			ModelName       string `json:"model_name"`
+			FirmwareVersion string `json:"firmware_version"`
+			SerialNumber    string `json:"serial_number"`
+			BoardType       string `json:"board_type"`
+			APMacAddress    string `json:"ap_mac_addr"`
+			APSSID          string `json:"ap_ssid"`
+		} `json:"info"`
+	}
+	if err := c.getJSON(ctx, "/gopro/camera/info", nil, &r); err != nil {
+		return command.Hardware{}, err
+	}
+
+	// Format the model number and MAC address the same way GetHardwareInfo over BLE does
+	model := uint32(r.Info.ModelNumber)
+	mac := r.Info.APMacAddress
+	if raw, err := hex.DecodeString(strings.ReplaceAll(mac, ":", "")); err == nil {
+		parts := make([]string, len(raw))
+		for i, b := range raw {
+			parts[i] = fmt.Sprintf("%x", b)
+		}
+		mac = strings.Join(parts, ":")
+	}
+
+	return command.Hardware{
+		ModelNumber:     fmt.Sprintf("%x:%x:%x:%x", byte(model>>24), byte(model>>16), byte(model>>8), byte(model)),
+		ModelName:       r.Info.ModelName,
+		Board:           r.Info.BoardType,
+		FirmwareVersion: r.Info.FirmwareVersion,
+		SerialNumber:    r.Info.SerialNumber,
+		SSID:            r.Info.APSSID,
+		SSIDMacAddress:  mac,
+	}, nil
+
+}
+
+// Start streaming the preview as MPEG-TS over UDP to "port" of the host, e.g. preview.DefaultPort
+func (c *Client) StartPreviewStream(ctx context.Context, port int) error {
+	return c.get(ctx, "/gopro/camera/stream/start", url.Values{"port": {strconv.Itoa(port)}})
+}
+
+// Stop streaming the preview
+func (c *Client) StopPreviewStream(ctx context.Context) error {
+	return c.get(ctx, "/gopro/camera/stream/stop", nil)
+}
+
+// Enable or disable control over the camera's USB network interface, see the usb package
+func (c *Client) SetWiredUSBControl(ctx context.Context, enabled bool) error {
+	return c.get(ctx, "/gopro/camera/control/wired_usb", url.Values{"p": {boolToParam(enabled)}})
+}
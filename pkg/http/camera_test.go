@@ -0,0 +1,50 @@
+package http
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thatpix3l/persephone/pkg/proto"
+)
+
+// Return a client for a server answering every request with "handler"
+func newTestClient(t *testing.T, handler nethttp.HandlerFunc) *Client {
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := New(server.URL, server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+
+}
+
+func TestGetPresetStatus(t *testing.T) {
+
+	c := newTestClient(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/gopro/camera/presets/get" {
+			t.Errorf("got path %q, want /gopro/camera/presets/get", r.URL.Path)
+		}
+		// Enums by name or number, with a field these definitions do not know of
+		w.Write([]byte(`{"presetGroupArray": [{"id": "PRESET_GROUP_ID_VIDEO", "presetArray": [{"id": 65536, "mode": 12, "isFixed": true, "newField": 1}]}]}`))
+	})
+
+	status, err := c.GetPresetStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	groups := status.GetPresetGroupArray()
+	if len(groups) != 1 || groups[0].GetId() != proto.EnumPresetGroup_PRESET_GROUP_ID_VIDEO {
+		t.Fatalf("got groups %v, want only the video group", groups)
+	}
+	if presets := groups[0].GetPresetArray(); len(presets) != 1 || presets[0].GetId() != 65536 || !presets[0].GetIsFixed() {
+		t.Errorf("got presets %v, want only the fixed preset 65536", presets)
+	}
+
+}
@@ -0,0 +1,142 @@
+// Client for the camera's HTTP API, served over Wi-Fi, USB and the home network, sharing its types with the BLE packages so an app can switch transports without changing its logic
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/control"
+)
+
+// Base URL of the camera's HTTP API while connected to its access point
+const DefaultURL = "http://10.5.5.9:8080"
+
+// Timeout of the HTTP client used when none is given
+const DefaultTimeout = 10 * time.Second
+
+// Returned when the camera answers a request with anything but 200 OK
+type StatusError struct {
+	Path       string
+	StatusCode int
+	Body       string // Usually JSON describing the failure, e.g. {"error": 2}
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: %d %s: %s", e.Path, e.StatusCode, nethttp.StatusText(e.StatusCode), e.Body)
+}
+
+// Client shares its common operations with camera.Camera
+var _ control.Controller = (*Client)(nil)
+
+type Client struct {
+	base   *url.URL
+	client *nethttp.Client
+}
+
+// Return a client for the camera's HTTP API at "baseURL", e.g. DefaultURL, sending requests with "client".
+// A nil client is replaced with one timing out after DefaultTimeout, while a client from cohn.Credentials.Client reaches a camera on the home network.
+func New(baseURL string, client *nethttp.Client) (*Client, error) {
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("base URL %q is not absolute", baseURL)
+	}
+
+	if client == nil {
+		client = &nethttp.Client{Timeout: DefaultTimeout}
+	}
+
+	return &Client{base: base, client: client}, nil
+
+}
+
+// Return the base URL requests are sent to
+func (c *Client) URL() *url.URL {
+	u := *c.base
+	return &u
+}
+
+// Return the URL of "path" with "query" parameters, relative to the base URL
+func (c *Client) resolve(path string, query url.Values) *url.URL {
+	u := *c.base
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	u.RawQuery = query.Encode()
+	return &u
+}
+
+// Send a request to "path", returning the response once it is known to be successful. The caller must close its body.
+func (c *Client) do(ctx context.Context, method string, path string, query url.Values, header nethttp.Header) (*nethttp.Response, error) {
+
+	req, err := nethttp.NewRequestWithContext(ctx, method, c.resolve(path, query).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, &StatusError{Path: path, StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	return resp, nil
+
+}
+
+// Send a GET request to "path", discarding the response
+func (c *Client) get(ctx context.Context, path string, query url.Values) error {
+
+	resp, err := c.do(ctx, nethttp.MethodGet, path, query, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body, so the connection can be reused
+	_, err = io.Copy(io.Discard, resp.Body)
+	return err
+
+}
+
+// Send a GET request to "path", decoding the JSON response into "v"
+func (c *Client) getJSON(ctx context.Context, path string, query url.Values, v interface{}) error {
+
+	resp, err := c.do(ctx, nethttp.MethodGet, path, query, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("%s: decoding response: %w", path, err)
+	}
+
+	return nil
+
+}
+
+func boolToParam(b bool) string {
+	if b {
+		return "1"
+	} else {
+		return "0"
+	}
+}
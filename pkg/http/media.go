@@ -0,0 +1,403 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Integer the camera reports as either a JSON number or a string holding one
+type flexInt int64
+
+func (f *flexInt) UnmarshalJSON(data []byte) error {
+
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*f = 0
+		return nil
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s is not an integer", data)
+	}
+
+	*f = flexInt(n)
+	return nil
+
+}
+
+// Kind of group a file heads
+type GroupType string
+
+const (
+	GroupBurst      GroupType = "b"
+	GroupContinuous GroupType = "c"
+	GroupNightLapse GroupType = "n"
+	GroupTimeLapse  GroupType = "t"
+)
+
+func (g GroupType) String() string {
+	switch g {
+	case GroupBurst:
+		return "Burst"
+	case GroupContinuous:
+		return "Continuous"
+	case GroupNightLapse:
+		return "Night Lapse"
+	case GroupTimeLapse:
+		return "Time Lapse"
+	}
+	return fmt.Sprintf("GroupType(%q)", string(g))
+}
+
+// Photos captured together, such as a burst or time lapse, listed as the single file that heads them
+type Group struct {
+	ID      int
+	Type    GroupType
+	First   int   // Number of the first photo
+	Last    int   // Number of the last photo
+	Missing []int // Numbers of photos between First and Last that have been deleted
+}
+
+// A file on the camera's storage, as listed by GetMediaList
+type File struct {
+	Directory  string // e.g. 100GOPRO
+	Name       string // e.g. GX010001.MP4
+	Created    time.Time
+	Modified   time.Time
+	Size       int64  // In bytes
+	LowResSize int64  // Size in bytes of the low resolution companion video, 0 if there is none
+	Group      *Group // Photos the file heads, nil if it is a single file
+}
+
+// Return the path of the file relative to the DCIM directory, e.g. 100GOPRO/GX010001.MP4, as taken by every media call
+func (f File) Path() string {
+	return path.Join(f.Directory, f.Name)
+}
+
+// Return the path of the low resolution companion video, e.g. 100GOPRO/GL010001.LRV, and whether there is one
+func (f File) LowResPath() (string, bool) {
+
+	if f.LowResSize <= 0 || len(f.Name) < 2 {
+		return "", false
+	}
+
+	name := "GL" + strings.TrimSuffix(f.Name[2:], path.Ext(f.Name)) + ".LRV"
+	return path.Join(f.Directory, name), true
+
+}
+
+// Return the paths of every photo in the group the file heads, or just the file itself if it heads none
+func (f File) GroupPaths() []string {
+
+	if f.Group == nil || len(f.Name) < 8 {
+		return []string{f.Path()}
+	}
+
+	missing := map[int]bool{}
+	for _, m := range f.Group.Missing {
+		missing[m] = true
+	}
+
+	// Group members share the file's prefix and extension, numbered with the last 4 digits, e.g. G0010008.JPG through G0010014.JPG
+	prefix, ext := f.Name[:4], path.Ext(f.Name)
+
+	paths := []string{}
+	for n := f.Group.First; n <= f.Group.Last; n++ {
+		if !missing[n] {
+			paths = append(paths, path.Join(f.Directory, fmt.Sprintf("%s%04d%s", prefix, n, ext)))
+		}
+	}
+
+	return paths
+
+}
+
+type Directory struct {
+	Name  string // e.g. 100GOPRO
+	Files []File
+}
+
+type MediaList struct {
+	ID          string // Changes whenever the media on the camera changes
+	Directories []Directory
+}
+
+// Return every file of every directory, in order
+func (m MediaList) Files() []File {
+	var files []File
+	for _, d := range m.Directories {
+		files = append(files, d.Files...)
+	}
+	return files
+}
+
+// Media list, as the camera encodes it
+type mediaListJSON struct {
+	ID    string `json:"id"`
+	Media []struct {
+		D  string `json:"d"`
+		FS []struct {
+			N    string    `json:"n"`
+			Cre  flexInt   `json:"cre"`
+			Mod  flexInt   `json:"mod"`
+			S    flexInt   `json:"s"`
+			Glrv flexInt   `json:"glrv"`
+			G    *flexInt  `json:"g"`
+			B    flexInt   `json:"b"`
+			L    flexInt   `json:"l"`
+			M    []flexInt `json:"m"`
+			T    string    `json:"t"`
+		} `json:"fs"`
+	} `json:"media"`
+}
+
+// Get every file on the camera's storage, grouped by directory
+func (c *Client) GetMediaList(ctx context.Context) (MediaList, error) {
+
+	var raw mediaListJSON
+	if err := c.getJSON(ctx, "/gopro/media/list", nil, &raw); err != nil {
+		return MediaList{}, err
+	}
+
+	list := MediaList{ID: raw.ID}
+	for _, d := range raw.Media {
+
+		dir := Directory{Name: d.D, Files: make([]File, 0, len(d.FS))}
+		for _, f := range d.FS {
+
+			file := File{
+				Directory:  d.D,
+				Name:       f.N,
+				Created:    time.Unix(int64(f.Cre), 0),
+				Modified:   time.Unix(int64(f.Mod), 0),
+				Size:       int64(f.S),
+				LowResSize: int64(f.Glrv),
+			}
+
+			if f.G != nil {
+				group := &Group{ID: int(*f.G), Type: GroupType(f.T), First: int(f.B), Last: int(f.L)}
+				for _, m := range f.M {
+					group.Missing = append(group.Missing, int(m))
+				}
+				file.Group = group
+			}
+
+			dir.Files = append(dir.Files, file)
+
+		}
+
+		list.Directories = append(list.Directories, dir)
+
+	}
+
+	return list, nil
+
+}
+
+// Called as a download progresses, with the bytes of the file written so far, including those skipped by resuming, and the file's total size, -1 if unknown
+type ProgressFunc func(written int64, total int64)
+
+// Counts bytes written through it, reporting progress after every write
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	if p.progress != nil {
+		p.progress(p.written, p.total)
+	}
+	return n, err
+}
+
+// Download the file at "mediaPath", e.g. 100GOPRO/GX010001.MP4, writing it to "w" starting from byte "offset" to resume an earlier download. "progress" may be nil.
+// Returns the count of bytes written to "w".
+func (c *Client) Download(ctx context.Context, mediaPath string, offset int64, w io.Writer, progress ProgressFunc) (int64, error) {
+
+	var header nethttp.Header
+	if offset > 0 {
+		header = nethttp.Header{"Range": {fmt.Sprintf("bytes=%d-", offset)}}
+	}
+
+	resp, err := c.do(ctx, nethttp.MethodGet, "/videos/DCIM/"+mediaPath, nil, header)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body := io.Reader(resp.Body)
+	if offset > 0 && resp.StatusCode != nethttp.StatusPartialContent {
+		// The camera ignored the range, so skip what was already downloaded
+		if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+			return 0, fmt.Errorf("skipping to offset %d: %w", offset, err)
+		}
+	}
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = resp.ContentLength
+		if resp.StatusCode == nethttp.StatusPartialContent {
+			total += offset
+		}
+	}
+
+	pw := &progressWriter{w: w, written: offset, total: total, progress: progress}
+	_, err = io.Copy(pw, body)
+
+	return pw.written - offset, err
+
+}
+
+// Download the file at "mediaPath" to the local file "dest", resuming from wherever an earlier, interrupted download left off. "progress" may be nil.
+func (c *Client) DownloadFile(ctx context.Context, mediaPath string, dest string, progress ProgressFunc) error {
+
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Download(ctx, mediaPath, info.Size(), f, progress)
+
+	// A range starting at the end of the file means it was already complete
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == nethttp.StatusRequestedRangeNotSatisfiable {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+
+}
+
+// Get the body of a media endpoint taking a "path" parameter
+func (c *Client) mediaBytes(ctx context.Context, endpoint string, mediaPath string) ([]byte, error) {
+
+	resp, err := c.do(ctx, nethttp.MethodGet, endpoint, url.Values{"path": {mediaPath}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+
+}
+
+// Get a small JPEG preview of the file at "mediaPath"
+func (c *Client) GetThumbnail(ctx context.Context, mediaPath string) ([]byte, error) {
+	return c.mediaBytes(ctx, "/gopro/media/thumbnail", mediaPath)
+}
+
+// Get a screen sized JPEG preview of the file at "mediaPath"
+func (c *Client) GetScreennail(ctx context.Context, mediaPath string) ([]byte, error) {
+	return c.mediaBytes(ctx, "/gopro/media/screennail", mediaPath)
+}
+
+// Get the GPMF telemetry, such as GPS and IMU readings, recorded with the file at "mediaPath"
+func (c *Client) GetGPMF(ctx context.Context, mediaPath string) ([]byte, error) {
+	return c.mediaBytes(ctx, "/gopro/media/gpmf", mediaPath)
+}
+
+// Metadata of a single file, as reported by GetMediaInfo
+type MediaInfo struct {
+	Created      time.Time
+	Size         int64         // In bytes
+	Width        int           // In pixels
+	Height       int           // In pixels
+	Duration     time.Duration // Of videos, 0 for photos
+	HilightCount int
+	ContentType  int // Media content type, e.g. video, time lapse or burst, as numbered by Open GoPro
+	IsProtuneOn  bool
+	IsAudioOnly  bool
+	Raw          map[string]json.RawMessage // Every field, including those not decoded above
+}
+
+// Get the metadata of the file at "mediaPath"
+func (c *Client) GetMediaInfo(ctx context.Context, mediaPath string) (MediaInfo, error) {
+
+	var raw map[string]json.RawMessage
+	if err := c.getJSON(ctx, "/gopro/media/info", url.Values{"path": {mediaPath}}, &raw); err != nil {
+		return MediaInfo{}, err
+	}
+
+	field := func(key string) (int64, error) {
+		value, ok := raw[key]
+		if !ok {
+			return 0, nil
+		}
+		var n flexInt
+		if err := n.UnmarshalJSON(value); err != nil {
+			return 0, fmt.Errorf("media info field %q: %w", key, err)
+		}
+		return int64(n), nil
+	}
+
+	var values [9]int64
+	for i, key := range []string{"cre", "s", "w", "h", "dur", "hc", "ct", "pro", "ao"} {
+		n, err := field(key)
+		if err != nil {
+			return MediaInfo{}, err
+		}
+		values[i] = n
+	}
+
+	return MediaInfo{
+		Created:      time.Unix(values[0], 0),
+		Size:         values[1],
+		Width:        int(values[2]),
+		Height:       int(values[3]),
+		Duration:     time.Duration(values[4]) * time.Second,
+		HilightCount: int(values[5]),
+		ContentType:  int(values[6]),
+		IsProtuneOn:  values[7] != 0,
+		IsAudioOnly:  values[8] != 0,
+		Raw:          raw,
+	}, nil
+
+}
+
+// Delete the single file at "mediaPath"
+func (c *Client) DeleteFile(ctx context.Context, mediaPath string) error {
+	return c.delete(ctx, "/gopro/media/delete/file", mediaPath)
+}
+
+// Delete every photo of the group headed by the file at "mediaPath", such as a burst or time lapse.
+// Unlike single files, groups are only deleted through the camera's legacy GET endpoint.
+func (c *Client) DeleteGroup(ctx context.Context, mediaPath string) error {
+	return c.get(ctx, "/gp/gpControl/command/storage/delete/group", url.Values{"p": {mediaPath}})
+}
+
+func (c *Client) delete(ctx context.Context, endpoint string, mediaPath string) error {
+
+	resp, err := c.do(ctx, nethttp.MethodDelete, endpoint, url.Values{"path": {mediaPath}}, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(io.Discard, resp.Body)
+	return err
+
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+)
+
+func TestDelete(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		delete func(c *Client) error
+		method string
+		path   string
+		query  string
+	}{
+		{"file", func(c *Client) error { return c.DeleteFile(context.Background(), "100GOPRO/GX010001.MP4") }, nethttp.MethodDelete, "/gopro/media/delete/file", "path=100GOPRO%2FGX010001.MP4"},
+		{"group", func(c *Client) error { return c.DeleteGroup(context.Background(), "100GOPRO/G0010011.JPG") }, nethttp.MethodGet, "/gp/gpControl/command/storage/delete/group", "p=100GOPRO%2FG0010011.JPG"},
+	}
+
+	for _, test := range tests {
+
+		c := newTestClient(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.Method != test.method || r.URL.Path != test.path || r.URL.RawQuery != test.query {
+				t.Errorf("%s: got %s %s?%s, want %s %s?%s", test.name, r.Method, r.URL.Path, r.URL.RawQuery, test.method, test.path, test.query)
+			}
+		})
+
+		if err := test.delete(c); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+
+	}
+
+}
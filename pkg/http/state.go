@@ -0,0 +1,164 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/settings"
+)
+
+// Encode "value", a status or setting value from the JSON state, into the [value_1, value_2, ...] bytes the camera reports it in over BLE
+func valueBytes(value json.RawMessage, width int) ([]byte, error) {
+
+	if bytes.HasPrefix(value, []byte(`"`)) {
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			return nil, err
+		}
+		return []byte(s), nil
+	}
+
+	n, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("value %s is not an integer or string", value)
+	}
+
+	if width == 0 {
+		return nil, fmt.Errorf("value %d is not a string", n)
+	}
+
+	// Two's complement, so negative values decode as such into signed fields
+	bits := uint(width) * 8
+	if bits < 64 && (n >= 1<<bits || n < -(1<<(bits-1))) {
+		return nil, fmt.Errorf("value %d does not fit in %d bytes", n, width)
+	}
+
+	b := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		b[i] = byte(n)
+		n >>= 8
+	}
+
+	return b, nil
+
+}
+
+// Return the IDs of "m" in ascending order
+func sortedIDs(m map[string]json.RawMessage) ([]uint8, error) {
+
+	ids := make([]uint8, 0, len(m))
+	for key := range m {
+		id, err := strconv.ParseUint(key, 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("ID %q is not a byte", key)
+		}
+		ids = append(ids, uint8(id))
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids, nil
+
+}
+
+// Decode the JSON status values into "r", the same way query.Unmarshal does with a BLE query response
+func unmarshalStatuses(values map[string]json.RawMessage, r *query.Response) (query.Report, error) {
+
+	var report query.Report
+
+	ids, err := sortedIDs(values)
+	if err != nil {
+		return report, err
+	}
+
+	for _, rawID := range ids {
+
+		id := query.StatusID(rawID)
+
+		field, ok := query.FieldByID(id)
+		if !ok {
+			report.Unknown = append(report.Unknown, id)
+			continue
+		}
+
+		value, err := valueBytes(values[strconv.Itoa(int(rawID))], field.Width)
+		if err == nil && len(value) > 0xff {
+			err = fmt.Errorf("value length %d exceeds maximum of 255", len(value))
+		}
+		if err == nil {
+			_, err = query.UnmarshalPartial(append([]byte{rawID, byte(len(value))}, value...), r)
+		}
+
+		report.Record(id, err, query.ErrUnknownStatus)
+
+	}
+
+	return report, nil
+
+}
+
+// Decode the JSON setting values into "r", the same way settings.Unmarshal does with a BLE query response
+func unmarshalSettings(values map[string]json.RawMessage, r *settings.Response) (settings.Report, error) {
+
+	var report settings.Report
+
+	ids, err := sortedIDs(values)
+	if err != nil {
+		return report, err
+	}
+
+	for _, rawID := range ids {
+
+		id := settings.ID(rawID)
+
+		// Setting values may be zero padded up to 8 bytes, leaving settings.UnmarshalPartial to check they fit the setting
+		value, err := valueBytes(values[strconv.Itoa(int(rawID))], 8)
+		if err == nil {
+			_, err = settings.UnmarshalPartial(append([]byte{rawID, byte(len(value))}, value...), r)
+		}
+
+		report.Record(id, err, settings.ErrUnknownSetting)
+
+	}
+
+	return report, nil
+
+}
+
+// Get every status and setting value, decoding them into "statuses" and "s", either of which may be nil to skip them.
+//
+// Every value is decoded independently, so an unknown or malformed value does not prevent the rest from being decoded, and is instead recorded in the returned reports.
+func (c *Client) GetState(ctx context.Context, statuses *query.Response, s *settings.Response) (query.Report, settings.Report, error) {
+
+	var state struct {
+		Status   map[string]json.RawMessage `json:"status"`
+		Settings map[string]json.RawMessage `json:"settings"`
+	}
+	if err := c.getJSON(ctx, "/gopro/camera/state", nil, &state); err != nil {
+		return query.Report{}, settings.Report{}, err
+	}
+
+	var statusReport query.Report
+	var settingReport settings.Report
+	var err error
+
+	if statuses != nil {
+		if statusReport, err = unmarshalStatuses(state.Status, statuses); err != nil {
+			return statusReport, settingReport, fmt.Errorf("decoding statuses: %w", err)
+		}
+	}
+
+	if s != nil {
+		if settingReport, err = unmarshalSettings(state.Settings, s); err != nil {
+			return statusReport, settingReport, fmt.Errorf("decoding settings: %w", err)
+		}
+	}
+
+	return statusReport, settingReport, nil
+
+}
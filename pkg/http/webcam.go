@@ -0,0 +1,12 @@
+package http
+
+import (
+	"context"
+	"net/url"
+)
+
+// Send a request to the webcam endpoint "action", e.g. "start" for /gopro/webcam/start, decoding its JSON response into "v".
+// Failed requests answer with the same JSON, left in the body of the StatusError. See the webcam package for typed calls.
+func (c *Client) WebcamRequest(ctx context.Context, action string, query url.Values, v interface{}) error {
+	return c.getJSON(ctx, "/gopro/webcam/"+action, query, v)
+}
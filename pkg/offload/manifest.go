@@ -0,0 +1,92 @@
+package offload
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+)
+
+// Name of the manifest file kept in the root of the destination directory
+const ManifestName = ".persephone-manifest.json"
+
+// A file that has been downloaded and verified
+type Entry struct {
+	Size       int64     `json:"size"`
+	SHA256     string    `json:"sha256"` // Hex encoded
+	Downloaded time.Time `json:"downloaded"`
+}
+
+// Record of every file downloaded into a directory, keyed by path relative to the camera's DCIM directory, e.g. 100GOPRO/GX010001.MP4.
+//
+// The checksums are taken of the local copy once downloaded, so they detect it being corrupted or replaced afterwards.
+// They do not verify the transfer itself, as the camera publishes no checksums; that rests on the size matching the camera's.
+type Manifest struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]Entry
+}
+
+// Load the manifest at "path", or start an empty one if it does not exist yet
+func LoadManifest(path string) (*Manifest, error) {
+
+	m := &Manifest{path: path, entries: map[string]Entry{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &m.entries); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+
+}
+
+// Return the entry for "mediaPath", and whether there is one
+func (m *Manifest) Get(mediaPath string) (Entry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[mediaPath]
+	return e, ok
+}
+
+// Record "e" for "mediaPath" and save the manifest
+func (m *Manifest) Put(mediaPath string, e Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[mediaPath] = e
+	return m.save()
+}
+
+// Forget "mediaPath" and save the manifest
+func (m *Manifest) Remove(mediaPath string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, mediaPath)
+	return m.save()
+}
+
+// Write the manifest to disk, replacing the previous one only once fully written. Must be called with the lock held.
+func (m *Manifest) save() error {
+
+	data, err := json.MarshalIndent(m.entries, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, m.path)
+
+}
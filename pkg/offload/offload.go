@@ -0,0 +1,304 @@
+// Mirrors a camera's media into a local directory over HTTP, checking every download against the size the camera reports and recording it in a manifest of SHA-256 checksums
+package offload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/http"
+)
+
+// Count of concurrent downloads when none is given
+const DefaultWorkers = 4
+
+type Options struct {
+	Workers           int  // Count of concurrent downloads, DefaultWorkers if zero
+	DeleteAfterVerify bool // Delete media from the camera once every file of it is downloaded and its size verified, keeping any whose size is unknown
+	TurboTransfer     bool // Enable Turbo Transfer while offloading, speeding up downloads at the cost of the camera's UI
+	LowRes            bool // Also download the low resolution companion of every video
+
+	// Called as each file downloads, with its path relative to the DCIM directory. Called concurrently from every worker.
+	Progress func(mediaPath string, written int64, total int64)
+}
+
+// Outcome of an offload, listing paths relative to the DCIM directory
+type Result struct {
+	Downloaded []string
+	Skipped    []string         // Already downloaded and verified by an earlier offload
+	Deleted    []string         // Deleted from the camera, by the path of the file heading them
+	Failed     map[string]error // Files that could not be downloaded, verified or deleted, and why
+}
+
+// Mirrors a camera's media into a local directory
+type Offloader struct {
+	client   *http.Client
+	dest     string
+	manifest *Manifest
+	options  Options
+}
+
+// Return an offloader mirroring the camera reached by "client" into "dest", keeping its manifest there.
+// Every camera should be given its own directory, as cameras name their files alike.
+func New(client *http.Client, dest string, options Options) (*Offloader, error) {
+
+	if err := os.MkdirAll(dest, 0o755); err != nil {
+		return nil, err
+	}
+
+	manifest, err := LoadManifest(filepath.Join(dest, ManifestName))
+	if err != nil {
+		return nil, fmt.Errorf("loading manifest: %w", err)
+	}
+
+	if options.Workers <= 0 {
+		options.Workers = DefaultWorkers
+	}
+
+	return &Offloader{client: client, dest: dest, manifest: manifest, options: options}, nil
+
+}
+
+// Return the manifest of every file downloaded so far
+func (o *Offloader) Manifest() *Manifest {
+	return o.manifest
+}
+
+// A file on the camera, and the paths of every file that must be offloaded along with it
+type item struct {
+	file  http.File
+	paths []string
+	sizes map[string]int64 // Sizes known from the media list, by path
+}
+
+// Offload every file on the camera, skipping those already downloaded and verified.
+//
+// Individual failures do not stop the offload, and are instead recorded in the result. Errors if the media list cannot be fetched or the context is done.
+func (o *Offloader) Run(ctx context.Context) (Result, error) {
+
+	result := Result{Failed: map[string]error{}}
+
+	if o.options.TurboTransfer {
+		if err := o.client.SetTurboTransfer(ctx, true); err != nil {
+			return result, fmt.Errorf("enabling turbo transfer: %w", err)
+		}
+		defer func() {
+			// Disable even if the offload was cancelled
+			ctx, cancel := context.WithTimeout(context.Background(), http.DefaultTimeout)
+			defer cancel()
+			o.client.SetTurboTransfer(ctx, false)
+		}()
+	}
+
+	list, err := o.client.GetMediaList(ctx)
+	if err != nil {
+		return result, fmt.Errorf("getting media list: %w", err)
+	}
+
+	items := make(chan item)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < o.options.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for it := range items {
+				o.offload(ctx, it, &result, &mu)
+			}
+		}()
+	}
+
+	for _, file := range list.Files() {
+
+		it := item{file: file, paths: file.GroupPaths(), sizes: map[string]int64{}}
+		if file.Group == nil {
+			it.sizes[file.Path()] = file.Size
+		}
+		if lowRes, ok := file.LowResPath(); ok && o.options.LowRes {
+			it.paths = append(it.paths, lowRes)
+			it.sizes[lowRes] = file.LowResSize
+		}
+
+		select {
+		case items <- it:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+	}
+
+	close(items)
+	wg.Wait()
+
+	sort.Strings(result.Downloaded)
+	sort.Strings(result.Skipped)
+	sort.Strings(result.Deleted)
+
+	return result, ctx.Err()
+
+}
+
+// Offload every file of "it", deleting it from the camera afterwards if asked to
+func (o *Offloader) offload(ctx context.Context, it item, result *Result, mu *sync.Mutex) {
+
+	verified := true
+	for _, mediaPath := range it.paths {
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		size, err := o.size(ctx, it, mediaPath)
+		if size < 0 {
+			// Without the size, a truncated download is indistinguishable from a complete one
+			verified = false
+		}
+
+		skipped := false
+		if err == nil {
+			skipped, err = o.fetch(ctx, mediaPath, size)
+		}
+
+		mu.Lock()
+		switch {
+		case err != nil:
+			result.Failed[mediaPath] = err
+			verified = false
+		case skipped:
+			result.Skipped = append(result.Skipped, mediaPath)
+		default:
+			result.Downloaded = append(result.Downloaded, mediaPath)
+		}
+		mu.Unlock()
+
+	}
+
+	if !verified || !o.options.DeleteAfterVerify {
+		return
+	}
+
+	var err error
+	if it.file.Group != nil {
+		err = o.client.DeleteGroup(ctx, it.file.Path())
+	} else {
+		err = o.client.DeleteFile(ctx, it.file.Path())
+	}
+
+	mu.Lock()
+	if err != nil {
+		result.Failed[it.file.Path()] = fmt.Errorf("deleting: %w", err)
+	} else {
+		result.Deleted = append(result.Deleted, it.file.Path())
+	}
+	mu.Unlock()
+
+}
+
+// Return the size of "mediaPath", a file of "it", asking the camera for it if the media list did not say.
+// Returns -1 if the size cannot be learnt, which only errors if the context is done.
+func (o *Offloader) size(ctx context.Context, it item, mediaPath string) (int64, error) {
+
+	if size, ok := it.sizes[mediaPath]; ok {
+		return size, nil
+	}
+
+	// The media list only gives the combined size of a group
+	info, err := o.client.GetMediaInfo(ctx, mediaPath)
+	if err != nil {
+		return -1, ctx.Err()
+	}
+	if _, ok := info.Raw["s"]; !ok {
+		return -1, nil
+	}
+
+	return info.Size, nil
+
+}
+
+// Return the hex encoded SHA-256 checksum and size of the local file at "path"
+func checksum(path string) (string, int64, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+
+}
+
+// Download and verify the file at "mediaPath", whose size is "size" or -1 if unknown, unless a verified copy already exists.
+// Returns true if the download was skipped.
+func (o *Offloader) fetch(ctx context.Context, mediaPath string, size int64) (bool, error) {
+
+	dest := filepath.Join(o.dest, filepath.FromSlash(mediaPath))
+
+	if entry, ok := o.manifest.Get(mediaPath); ok && (size < 0 || entry.Size == size) {
+		sum, n, err := checksum(dest)
+		if err == nil && sum == entry.SHA256 && n == entry.Size {
+			return true, nil
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return false, err
+		}
+		// The local copy is missing or corrupt, so download it again
+		if err := o.manifest.Remove(mediaPath); err != nil {
+			return false, err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return false, err
+	}
+
+	// Download next to the final file, resuming whatever an interrupted offload left behind
+	partial := dest + ".part"
+
+	var progress http.ProgressFunc
+	if o.options.Progress != nil {
+		progress = func(written int64, total int64) {
+			o.options.Progress(mediaPath, written, total)
+		}
+	}
+
+	if err := o.client.DownloadFile(ctx, mediaPath, partial, progress); err != nil {
+		return false, err
+	}
+
+	sum, n, err := checksum(partial)
+	if err != nil {
+		return false, err
+	}
+
+	if size >= 0 && n != size {
+		// Start over rather than resume a file that cannot be trusted
+		os.Remove(partial)
+		return false, fmt.Errorf("downloaded %d bytes, expected %d", n, size)
+	}
+
+	if err := os.Rename(partial, dest); err != nil {
+		return false, err
+	}
+
+	return false, o.manifest.Put(mediaPath, Entry{Size: n, SHA256: sum, Downloaded: time.Now()})
+
+}
@@ -0,0 +1,157 @@
+package packet
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnexpectedContinuation = errors.New("continuation packet received without a start packet")
+	ErrOutOfOrder             = errors.New("continuation packet received out of order")
+	ErrTruncated              = errors.New("start packet received before previous message was complete")
+	ErrOverflow               = errors.New("packet payload exceeds remaining message length")
+)
+
+// Reassembles the packets of a single characteristic's notifications into complete messages.
+//
+// The zero value is ready to use.
+type Accumulator struct {
+	message   []byte // Payload received so far
+	length    int    // Total length of the message, as declared by its start header
+	counter   int    // Index of the next expected continuation packet
+	receiving bool   // True between a start packet and the packet completing its message
+}
+
+// Return a new, empty accumulator
+func NewAccumulator() *Accumulator {
+	return &Accumulator{}
+}
+
+// Parse the start header at the beginning of "packet", returning the declared message length and the size of the header
+func parseStartHeader(packet []byte) (int, int, error) {
+
+	switch packet[0] & headerTypeMask {
+
+	case headerGeneral:
+		return int(packet[0] & lengthMask), 1, nil
+
+	case headerExtended13:
+		if len(packet) < 2 {
+			return 0, 0, fmt.Errorf("extended 13-bit header is truncated: %v", packet)
+		}
+		return int(packet[0]&lengthMask)<<8 | int(packet[1]), 2, nil
+
+	case headerExtended16:
+		if len(packet) < 3 {
+			return 0, 0, fmt.Errorf("extended 16-bit header is truncated: %v", packet)
+		}
+		return int(packet[1])<<8 | int(packet[2]), 3, nil
+
+	}
+
+	return 0, 0, fmt.Errorf("start header type is reserved: %v", packet)
+
+}
+
+// Return true if a message is partially received
+func (a *Accumulator) Receiving() bool {
+	return a.receiving
+}
+
+// Discard any partially received message
+func (a *Accumulator) Reset() {
+	*a = Accumulator{}
+}
+
+// Consume a single notification. Once the notification completing a message is consumed, the message is returned without its headers. Otherwise, the returned message is nil.
+//
+// Errors if the packet is empty, malformed, or breaks the sequence of the message in progress. Any partially received message is discarded on error, except for a start packet arriving mid-message, which is kept as the beginning of a new message.
+func (a *Accumulator) Accumulate(packet []byte) ([]byte, error) {
+
+	if len(packet) == 0 {
+		return nil, errors.New("packet is empty")
+	}
+
+	// Start of a new message
+	if packet[0]&continuationBit == 0 {
+
+		var truncatedErr error = nil
+		if a.receiving {
+			truncatedErr = fmt.Errorf("%w: received %d of %d bytes", ErrTruncated, len(a.message), a.length)
+		}
+		a.Reset()
+
+		length, headerSize, err := parseStartHeader(packet)
+		if err != nil {
+			return nil, err
+		}
+
+		a.length = length
+		a.receiving = true
+		message, err := a.append(packet[headerSize:])
+		if err != nil {
+			return nil, err
+		}
+
+		return message, truncatedErr
+
+	}
+
+	if !a.receiving {
+		return nil, ErrUnexpectedContinuation
+	}
+
+	if counter := int(packet[0] & counterMask); counter != a.counter&counterMask {
+		a.Reset()
+		return nil, fmt.Errorf("%w: expected counter %d, got %d", ErrOutOfOrder, a.counter&counterMask, counter)
+	}
+	a.counter++
+
+	return a.append(packet[1:])
+
+}
+
+// Append a packet's payload to the message in progress, returning the message if it is now complete
+func (a *Accumulator) append(payload []byte) ([]byte, error) {
+
+	if received := len(a.message) + len(payload); received > a.length {
+		length := a.length
+		a.Reset()
+		return nil, fmt.Errorf("%w: message length is %d, received %d", ErrOverflow, length, received)
+	}
+
+	a.message = append(a.message, payload...)
+	if len(a.message) < a.length {
+		return nil, nil
+	}
+
+	// Never nil, even for an empty message, so it cannot be mistaken for an incomplete one
+	message := append([]byte{}, a.message...)
+	a.Reset()
+	return message, nil
+
+}
+
+// Reassembles notifications from several characteristics at once, keeping a separate Accumulator per characteristic.
+//
+// The zero value is ready to use.
+type Accumulators[K comparable] struct {
+	accumulators map[K]*Accumulator
+}
+
+// Consume a single notification received from the characteristic identified by "key", see Accumulator.Accumulate
+func (a *Accumulators[K]) Accumulate(key K, packet []byte) ([]byte, error) {
+
+	if a.accumulators == nil {
+		a.accumulators = map[K]*Accumulator{}
+	}
+
+	accumulator, ok := a.accumulators[key]
+	if !ok {
+		accumulator = NewAccumulator()
+		a.accumulators[key] = accumulator
+	}
+
+	return accumulator.Accumulate(packet)
+
+}
@@ -0,0 +1,170 @@
+package packet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// Return a message of "length" bytes, each distinct from its neighbours so misplaced payloads are caught
+func testMessage(length int) []byte {
+	message := make([]byte, length)
+	for i := range message {
+		message[i] = byte(i * 7)
+	}
+	return message
+}
+
+func TestAccumulateFragmented(t *testing.T) {
+
+	lengths := []int{
+		0, 1,
+		MaxGeneralLength, MaxGeneralLength + 1,
+		MaxExtended13Length, MaxExtended13Length + 1,
+		MaxExtended16Length,
+	}
+
+	for _, length := range lengths {
+		for _, mtu := range []int{maxStartHeaderSize + 1, DefaultMTU, 512} {
+
+			message := testMessage(length)
+			packets, err := Fragment(message, mtu)
+			if err != nil {
+				t.Fatalf("length %d, mtu %d: %v", length, mtu, err)
+			}
+
+			var a Accumulator
+			for i, p := range packets {
+
+				got, err := a.Accumulate(p)
+				if err != nil {
+					t.Fatalf("length %d, mtu %d, packet %d: %v", length, mtu, i, err)
+				}
+
+				last := i == len(packets)-1
+				if !last && got != nil {
+					t.Fatalf("length %d, mtu %d: message completed early at packet %d of %d", length, mtu, i, len(packets))
+				}
+				if last && (got == nil || !bytes.Equal(got, message)) {
+					t.Fatalf("length %d, mtu %d: reassembled %d bytes that do not match the message", length, mtu, len(got))
+				}
+
+			}
+
+			if a.Receiving() {
+				t.Errorf("length %d, mtu %d: still receiving after the last packet", length, mtu)
+			}
+
+		}
+	}
+
+}
+
+func TestAccumulateErrors(t *testing.T) {
+
+	packets, err := Fragment(testMessage(60), DefaultMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		packets [][]byte
+		err     error
+	}{
+		{"continuation without start", packets[1:], ErrUnexpectedContinuation},
+		{"skipped continuation", [][]byte{packets[0], packets[2]}, ErrOutOfOrder},
+		{"start before complete", [][]byte{packets[0], packets[1], packets[0]}, ErrTruncated},
+		{"payload beyond length", [][]byte{{0x02, 1, 2, 3}}, ErrOverflow},
+	}
+
+	for _, test := range tests {
+
+		var a Accumulator
+		var err error
+		for _, p := range test.packets {
+			if _, err = a.Accumulate(p); err != nil {
+				break
+			}
+		}
+
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: got error %v, want %v", test.name, err, test.err)
+		}
+
+	}
+
+}
+
+func TestAccumulateTruncatedHeader(t *testing.T) {
+
+	for _, p := range [][]byte{{}, {0x20}, {0x40, 0x01}, {0x60}} {
+		var a Accumulator
+		if _, err := a.Accumulate(p); err == nil {
+			t.Errorf("packet %x did not error", p)
+		}
+	}
+
+}
+
+func TestAccumulateRestartsAfterTruncation(t *testing.T) {
+
+	first, err := Fragment(testMessage(60), DefaultMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second := []byte{1, 2, 3}
+	packets, err := Fragment(second, DefaultMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var a Accumulator
+	if _, err := a.Accumulate(first[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	// The new start packet is kept, completing its own message despite the error for the one it cut short
+	got, err := a.Accumulate(packets[0])
+	if !errors.Is(err, ErrTruncated) {
+		t.Errorf("got error %v, want %v", err, ErrTruncated)
+	}
+	if !bytes.Equal(got, second) {
+		t.Errorf("got message %x, want %x", got, second)
+	}
+
+}
+
+func TestAccumulators(t *testing.T) {
+
+	one, err := Fragment(testMessage(40), DefaultMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	two, err := Fragment(testMessage(50), DefaultMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Interleaving messages on separate keys must not disturb either
+	var a Accumulators[string]
+	var gotOne, gotTwo []byte
+	for i := 0; i < len(one) || i < len(two); i++ {
+		if i < len(one) {
+			if gotOne, err = a.Accumulate("one", one[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if i < len(two) {
+			if gotTwo, err = a.Accumulate("two", two[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if !bytes.Equal(gotOne, testMessage(40)) || !bytes.Equal(gotTwo, testMessage(50)) {
+		t.Error("interleaved messages were not reassembled")
+	}
+
+}
@@ -0,0 +1,112 @@
+// Utilities for framing messages into the BLE packets understood by the GoPro
+package packet
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Default maximum size of a single packet, in bytes, including its header
+const DefaultMTU = 20
+
+// Largest message lengths describable by each start header
+const (
+	MaxGeneralLength    = 1<<5 - 1
+	MaxExtended13Length = 1<<13 - 1
+	MaxExtended16Length = 1<<16 - 1
+)
+
+// Bits of the first header byte
+const (
+	continuationBit = 0x80 // Set on every continuation packet
+	headerTypeMask  = 0x60 // Start header type, only valid on start packets
+	counterMask     = 0x0f // Continuation counter, only valid on continuation packets
+	lengthMask      = 0x1f // Upper bits of the message length for General and Extended-13 headers
+)
+
+// Start header types
+const (
+	headerGeneral    = 0x00
+	headerExtended13 = 0x20
+	headerExtended16 = 0x40
+)
+
+// Size of the largest possible start header, used as the lower bound for an MTU
+const maxStartHeaderSize = 3
+
+// Smallest MTU a message can be fragmented to, fitting the largest start header and a byte of payload
+const MinMTU = maxStartHeaderSize + 1
+
+// Return the start header describing a message of the given length, picking the smallest header that fits
+func StartHeader(length int) ([]byte, error) {
+
+	switch {
+
+	case length < 0:
+		return nil, fmt.Errorf("message length %d is negative", length)
+
+	case length <= MaxGeneralLength:
+		return []byte{headerGeneral | byte(length)}, nil
+
+	case length <= MaxExtended13Length:
+		return []byte{headerExtended13 | byte(length>>8), byte(length)}, nil
+
+	case length <= MaxExtended16Length:
+		return []byte{headerExtended16, byte(length >> 8), byte(length)}, nil
+
+	}
+
+	return nil, fmt.Errorf("message length %d exceeds maximum of %d", length, MaxExtended16Length)
+
+}
+
+// Return the header of the continuation packet at the given index, counting from 0 for the first continuation packet
+func ContinuationHeader(index int) byte {
+	return continuationBit | byte(index)&counterMask
+}
+
+// Split "message" into a start packet followed by as many continuation packets as needed, none of them larger than "mtu" bytes.
+//
+// Errors if the message is too long for any start header, or the MTU cannot fit a start header and at least one byte of payload.
+func Fragment(message []byte, mtu int) ([][]byte, error) {
+
+	if mtu < MinMTU {
+		return nil, fmt.Errorf("mtu %d is less than minimum of %d", mtu, MinMTU)
+	}
+
+	if message == nil {
+		return nil, errors.New("message is nil")
+	}
+
+	header, err := StartHeader(len(message))
+	if err != nil {
+		return nil, err
+	}
+
+	// Start packet, holding as much of the message as fits after its header
+	firstSize := min(len(message), mtu-len(header))
+	packets := [][]byte{append(header, message[:firstSize]...)}
+	message = message[firstSize:]
+
+	// Continuation packets, each with a single byte header
+	for i := 0; len(message) > 0; i++ {
+		size := min(len(message), mtu-1)
+		packets = append(packets, append([]byte{ContinuationHeader(i)}, message[:size]...))
+		message = message[size:]
+	}
+
+	return packets, nil
+
+}
+
+// Split "message" into packets no larger than DefaultMTU, ready to be written to a characteristic. Every message builder frames its messages with this.
+func Frame(message []byte) ([][]byte, error) {
+	return Fragment(message, DefaultMTU)
+}
+
+func min(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,79 @@
+package packet
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStartHeader(t *testing.T) {
+
+	tests := []struct {
+		length int
+		header []byte
+	}{
+		{0, []byte{0x00}},
+		{MaxGeneralLength, []byte{0x1f}},
+		{MaxGeneralLength + 1, []byte{0x20, 0x20}},
+		{MaxExtended13Length, []byte{0x3f, 0xff}},
+		{MaxExtended13Length + 1, []byte{0x40, 0x20, 0x00}},
+		{MaxExtended16Length, []byte{0x40, 0xff, 0xff}},
+	}
+
+	for _, test := range tests {
+		header, err := StartHeader(test.length)
+		if err != nil {
+			t.Errorf("StartHeader(%d): %v", test.length, err)
+			continue
+		}
+		if !bytes.Equal(header, test.header) {
+			t.Errorf("StartHeader(%d) = %x, want %x", test.length, header, test.header)
+		}
+	}
+
+	for _, length := range []int{-1, MaxExtended16Length + 1} {
+		if _, err := StartHeader(length); err == nil {
+			t.Errorf("StartHeader(%d) did not error", length)
+		}
+	}
+
+}
+
+func TestFragment(t *testing.T) {
+
+	message := make([]byte, 40)
+	for i := range message {
+		message[i] = byte(i)
+	}
+
+	packets, err := Fragment(message, DefaultMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2 byte header and 18 bytes, then 19 bytes and 3 bytes behind 1 byte headers
+	want := [][]byte{
+		append([]byte{0x20, 40}, message[:18]...),
+		append([]byte{0x80}, message[18:37]...),
+		append([]byte{0x81}, message[37:]...),
+	}
+
+	if len(packets) != len(want) {
+		t.Fatalf("got %d packets, want %d", len(packets), len(want))
+	}
+	for i := range want {
+		if !bytes.Equal(packets[i], want[i]) {
+			t.Errorf("packet %d = %x, want %x", i, packets[i], want[i])
+		}
+	}
+
+	for _, mtu := range []int{0, maxStartHeaderSize} {
+		if _, err := Fragment(message, mtu); err == nil {
+			t.Errorf("Fragment with mtu %d did not error", mtu)
+		}
+	}
+
+	if _, err := Fragment(nil, DefaultMTU); err == nil {
+		t.Error("Fragment of a nil message did not error")
+	}
+
+}
@@ -0,0 +1,180 @@
+// Receiver for the camera's preview stream, demultiplexing the H.264 video out of the MPEG-TS it sends over UDP
+package preview
+
+import (
+	"io"
+	"net"
+	"strconv"
+	"sync"
+)
+
+// Port the camera streams the preview to unless told otherwise
+const DefaultPort = 8554
+
+// Count of NAL units buffered for a slow reader before new ones are dropped
+const nalBuffer = 256
+
+// A single H.264 NAL unit, without its start code
+type NALUnit struct {
+	Type byte // e.g. 5 for an IDR slice, 7 for a sequence parameter set
+	Data []byte
+}
+
+// Counters describing the health of the stream so far
+type Stats struct {
+	Datagrams        uint64 // UDP datagrams received
+	Packets          uint64 // TS packets received
+	LostPackets      uint64 // TS packets detected missing by their continuity counters
+	MalformedPackets uint64 // TS packets or datagrams that could not be parsed
+	CorruptFrames    uint64 // PES packets discarded because a TS packet of theirs was lost
+	DroppedNALUnits  uint64 // NAL units discarded because the reader fell behind
+}
+
+type Receiver struct {
+	conn  net.PacketConn
+	units chan NALUnit
+
+	statsMu sync.Mutex
+	stats   Stats
+}
+
+// Listen for the preview stream on "port" of every interface, e.g. DefaultPort. Start the stream with http.Client.StartPreviewStream.
+func Listen(port int) (*Receiver, error) {
+
+	conn, err := net.ListenPacket("udp", ":"+strconv.Itoa(port))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReceiver(conn), nil
+
+}
+
+// Return a receiver reading the preview stream from "conn", closing it along with the receiver
+func NewReceiver(conn net.PacketConn) *Receiver {
+	r := &Receiver{conn: conn, units: make(chan NALUnit, nalBuffer)}
+	go r.receive()
+	return r
+}
+
+// Stop receiving, closing the NAL unit channel once every buffered unit is read
+func (r *Receiver) Close() error {
+	return r.conn.Close()
+}
+
+// Return every NAL unit of the stream, in order. Closed once the receiver is.
+func (r *Receiver) NALUnits() <-chan NALUnit {
+	return r.units
+}
+
+// Return a snapshot of the stream's counters
+func (r *Receiver) Stats() Stats {
+	r.statsMu.Lock()
+	defer r.statsMu.Unlock()
+	return r.stats
+}
+
+// Return the NAL units of elementary stream data
+func nalUnits(es []byte) []NALUnit {
+	var units []NALUnit
+	for _, data := range splitNALUnits(es) {
+		units = append(units, NALUnit{Type: data[0] & 0x1f, Data: data})
+	}
+	return units
+}
+
+// Hand "units" to the reader, dropping those it has no room for, and fold the demuxer's counters into the stats
+func (r *Receiver) publish(demux *demuxer, units []NALUnit, update func(*Stats)) {
+
+	var dropped uint64
+	for _, unit := range units {
+		select {
+		case r.units <- unit:
+		default:
+			dropped++
+		}
+	}
+
+	r.statsMu.Lock()
+	update(&r.stats)
+	r.stats.LostPackets = demux.lost
+	r.stats.MalformedPackets += demux.malformed
+	demux.malformed = 0
+	r.stats.CorruptFrames = demux.corrupt
+	r.stats.DroppedNALUnits += dropped
+	r.statsMu.Unlock()
+
+}
+
+func (r *Receiver) receive() {
+
+	defer close(r.units)
+
+	demux := newDemuxer()
+	buf := make([]byte, 65536)
+
+	for {
+
+		n, _, err := r.conn.ReadFrom(buf)
+		if err != nil {
+			// The last PES packet is only complete once no more packets follow it
+			r.publish(demux, nalUnits(demux.flush()), func(*Stats) {})
+			return
+		}
+
+		var units []NALUnit
+		var malformed uint64
+		packets := uint64(n / packetSize)
+		if n%packetSize != 0 {
+			malformed++
+		}
+
+		for i := 0; i+packetSize <= n; i += packetSize {
+
+			es, err := demux.packet(buf[i : i+packetSize])
+			if err != nil {
+				malformed++
+				continue
+			}
+
+			units = append(units, nalUnits(es)...)
+
+		}
+
+		r.publish(demux, units, func(stats *Stats) {
+			stats.Datagrams++
+			stats.Packets += packets
+			stats.MalformedPackets += malformed
+		})
+
+	}
+
+}
+
+// Reads NAL units as an Annex B elementary stream, ready to be written to a .h264 file or piped to a decoder
+type reader struct {
+	units <-chan NALUnit
+	buf   []byte
+}
+
+// Return the receiver's NAL units as an Annex B H.264 elementary stream, ending with io.EOF once the receiver is closed.
+// Reading competes with NALUnits for the same units, so only one of them should be used.
+func (r *Receiver) Reader() io.Reader {
+	return &reader{units: r.units}
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+
+	for len(r.buf) == 0 {
+		unit, ok := <-r.units
+		if !ok {
+			return 0, io.EOF
+		}
+		r.buf = append([]byte{0, 0, 0, 1}, unit.Data...)
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+
+}
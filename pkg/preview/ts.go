@@ -0,0 +1,240 @@
+package preview
+
+import (
+	"bytes"
+	"errors"
+)
+
+// Size of every MPEG-TS packet
+const packetSize = 188
+
+const (
+	syncByte     = 0x47
+	patPID       = 0x0000
+	streamH264   = 0x1b // PMT stream type of H.264 video
+	maxPESBuffer = 4 << 20
+)
+
+var (
+	errSync   = errors.New("missing sync byte")
+	errLength = errors.New("packet is too short")
+)
+
+// Demultiplexes the H.264 elementary stream out of MPEG-TS packets
+type demuxer struct {
+	pmtPID   int // -1 until the PAT is seen
+	videoPID int // -1 until the PMT is seen
+
+	continuity map[int]byte // Last continuity counter of every PID
+
+	pes        []byte // PES packet being reassembled
+	pesCorrupt bool   // A packet of the PES packet being reassembled was lost
+
+	lost      uint64 // TS packets detected missing by their continuity counters
+	malformed uint64 // TS packets that could not be parsed
+	corrupt   uint64 // PES packets discarded because a TS packet of theirs was lost
+}
+
+func newDemuxer() *demuxer {
+	return &demuxer{pmtPID: -1, videoPID: -1, continuity: map[int]byte{}}
+}
+
+// Return the payload of the PSI section in "payload", skipping its pointer field
+func psiSection(payload []byte) ([]byte, bool) {
+	if len(payload) < 1 || len(payload) < 1+int(payload[0]) {
+		return nil, false
+	}
+	section := payload[1+int(payload[0]):]
+	if len(section) < 3 {
+		return nil, false
+	}
+	end := 3 + (int(section[1]&0x0f)<<8 | int(section[2]))
+	if end > len(section) {
+		return nil, false
+	}
+	return section[:end], true
+}
+
+// Find the PID of the first program's PMT in a PAT section
+func (d *demuxer) parsePAT(section []byte) {
+
+	// Skip the 8 byte header, and stop before the 4 byte CRC
+	for i := 8; i+4 <= len(section)-4; i += 4 {
+		program := int(section[i])<<8 | int(section[i+1])
+		if program != 0 {
+			d.pmtPID = int(section[i+2]&0x1f)<<8 | int(section[i+3])
+			return
+		}
+	}
+
+}
+
+// Find the PID of the H.264 stream in a PMT section
+func (d *demuxer) parsePMT(section []byte) {
+
+	if len(section) < 12 {
+		return
+	}
+
+	i := 12 + (int(section[10]&0x0f)<<8 | int(section[11]))
+	for i+5 <= len(section)-4 {
+		streamType := section[i]
+		pid := int(section[i+1]&0x1f)<<8 | int(section[i+2])
+		if streamType == streamH264 {
+			d.videoPID = pid
+			return
+		}
+		i += 5 + (int(section[i+3]&0x0f)<<8 | int(section[i+4]))
+	}
+
+}
+
+// Return the elementary stream data of a complete PES packet
+func pesPayload(pes []byte) ([]byte, bool) {
+	if len(pes) < 9 || pes[0] != 0 || pes[1] != 0 || pes[2] != 1 {
+		return nil, false
+	}
+	start := 9 + int(pes[8])
+	if start > len(pes) {
+		return nil, false
+	}
+	return pes[start:], true
+}
+
+// Finish the PES packet being reassembled, returning its elementary stream data if it is intact
+func (d *demuxer) flush() []byte {
+
+	pes, corrupt := d.pes, d.pesCorrupt
+	d.pes, d.pesCorrupt = nil, false
+
+	if pes == nil {
+		return nil
+	}
+
+	if corrupt {
+		d.corrupt++
+		return nil
+	}
+
+	es, ok := pesPayload(pes)
+	if !ok {
+		d.malformed++
+		return nil
+	}
+
+	return es
+
+}
+
+// Consume a single TS packet, returning the elementary stream data of any PES packet it completes
+func (d *demuxer) packet(p []byte) ([]byte, error) {
+
+	if len(p) < packetSize {
+		return nil, errLength
+	}
+	if p[0] != syncByte {
+		return nil, errSync
+	}
+
+	start := p[1]&0x40 != 0
+	pid := int(p[1]&0x1f)<<8 | int(p[2])
+	control := (p[3] >> 4) & 0x3
+	counter := p[3] & 0x0f
+
+	// Packets without a payload do not advance the continuity counter
+	if control&0x1 == 0 {
+		return nil, nil
+	}
+
+	payload := p[4:packetSize]
+	if control&0x2 != 0 {
+		if len(payload) < 1 || 1+int(payload[0]) > len(payload) {
+			return nil, errLength
+		}
+		payload = payload[1+int(payload[0]):]
+	}
+
+	lost := false
+	if last, ok := d.continuity[pid]; ok {
+		if counter == last {
+			// Duplicate packet, which the standard allows once
+			return nil, nil
+		}
+		if missing := (counter - last - 1) & 0x0f; missing != 0 {
+			d.lost += uint64(missing)
+			lost = true
+		}
+	}
+	d.continuity[pid] = counter
+
+	switch pid {
+
+	case patPID:
+		if section, ok := psiSection(payload); ok && start {
+			d.parsePAT(section)
+		}
+		return nil, nil
+
+	case d.pmtPID:
+		if section, ok := psiSection(payload); ok && start {
+			d.parsePMT(section)
+		}
+		return nil, nil
+
+	case d.videoPID:
+
+		var es []byte
+		if start {
+			// A lost packet before a start may have been the end of the previous PES packet
+			d.pesCorrupt = d.pesCorrupt || lost
+			es = d.flush()
+			d.pes = append([]byte{}, payload...)
+		} else if d.pes != nil {
+			d.pes = append(d.pes, payload...)
+			d.pesCorrupt = d.pesCorrupt || lost
+			if len(d.pes) > maxPESBuffer {
+				// Never completed, most likely because the next start was lost
+				d.pes, d.pesCorrupt = nil, false
+				d.corrupt++
+			}
+		}
+
+		return es, nil
+
+	}
+
+	return nil, nil
+
+}
+
+var startCode = []byte{0, 0, 1}
+
+// Split Annex B elementary stream data into NAL units, without their start codes
+func splitNALUnits(es []byte) [][]byte {
+
+	var units [][]byte
+
+	i := bytes.Index(es, startCode)
+	for i >= 0 {
+
+		es = es[i+len(startCode):]
+
+		next := bytes.Index(es, startCode)
+		unit := es
+		if next >= 0 {
+			unit = es[:next]
+		}
+
+		// Trailing zeros belong to the next start code, e.g. the leading zero of a 4 byte start code
+		unit = bytes.TrimRight(unit, "\x00")
+		if len(unit) > 0 {
+			units = append(units, append([]byte{}, unit...))
+		}
+
+		i = next
+
+	}
+
+	return units
+
+}
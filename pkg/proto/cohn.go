@@ -0,0 +1,31 @@
+package proto
+
+import protobuf "google.golang.org/protobuf/proto"
+
+// Get the COHN status, optionally registering for notifications when it changes. Written to the query characteristic, answered with NotifyCOHNStatus.
+func (a actionT) GetCOHNStatus(register bool) ([][]byte, error) {
+	r := &RequestGetCOHNStatus{RegisterCohnStatus: protobuf.Bool(register)}
+	return buildAction(FeatureQuery, ActionGetCOHNStatus, r)
+}
+
+// Create the camera's COHN certificate. Written to the command characteristic, answered with ResponseGeneric.
+func (a actionT) CreateCOHNCertificate(override bool) ([][]byte, error) {
+	r := &RequestCreateCOHNCert{Override: protobuf.Bool(override)}
+	return buildAction(FeatureCommand, ActionCreateCOHNCert, r)
+}
+
+// Clear the camera's COHN certificate, unprovisioning it. Written to the command characteristic, answered with ResponseGeneric.
+func (a actionT) ClearCOHNCertificate() ([][]byte, error) {
+	return buildAction(FeatureCommand, ActionClearCOHNCert, &RequestClearCOHNCert{})
+}
+
+// Get the camera's COHN root CA certificate. Written to the query characteristic, answered with ResponseCOHNCert.
+func (a actionT) GetCOHNCertificate() ([][]byte, error) {
+	return buildAction(FeatureQuery, ActionGetCOHNCert, &RequestCOHNCert{})
+}
+
+// Enable or disable COHN, keeping the camera's provisioning either way. Written to the command characteristic, answered with ResponseGeneric.
+func (a actionT) SetCOHNActive(active bool) ([][]byte, error) {
+	r := &RequestSetCOHNSetting{CohnActive: protobuf.Bool(active)}
+	return buildAction(FeatureCommand, ActionSetCOHNSetting, r)
+}
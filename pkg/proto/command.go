@@ -0,0 +1,24 @@
+package proto
+
+import (
+	"github.com/thatpix3l/persephone/pkg/query"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Tell the camera who is in control of it, e.g. external control to keep its UI from interfering. Written to the command characteristic, answered with ResponseGeneric.
+// EnumCameraControlStatus shares its values with the status.
+func (a actionT) SetCameraControlStatus(status query.CameraControlStatus) ([][]byte, error) {
+	r := &RequestSetCameraControlStatus{CameraControlStatus: EnumCameraControlStatus(status).Enum()}
+	return buildAction(FeatureCommand, ActionSetCameraControlStatus, r)
+}
+
+// Enable or disable Turbo Transfer, speeding up media offload at the cost of the camera's UI. Written to the command characteristic, answered with ResponseGeneric.
+func (a actionT) SetTurboTransfer(active bool) ([][]byte, error) {
+	r := &RequestSetTurboActive{Active: protobuf.Bool(active)}
+	return buildAction(FeatureCommand, ActionSetTurboActive, r)
+}
+
+// Get the file most recently captured. Written to the query characteristic, answered with ResponseLastCapturedMedia.
+func (a actionT) GetLastCapturedMedia() ([][]byte, error) {
+	return buildAction(FeatureQuery, ActionGetLastCapturedMedia, &RequestGetLastCapturedMedia{})
+}
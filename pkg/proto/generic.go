@@ -0,0 +1,20 @@
+package proto
+
+import "fmt"
+
+// Return nil on success, otherwise an error naming the result
+func (x EnumResultGeneric) Err() error {
+	if x == EnumResultGeneric_RESULT_SUCCESS {
+		return nil
+	}
+	return &ResultError{Result: x}
+}
+
+// Returned when the camera answers a protobuf request with anything but success
+type ResultError struct {
+	Result EnumResultGeneric
+}
+
+func (e *ResultError) Error() string {
+	return fmt.Sprintf("protobuf request failed: %v", e.Result)
+}
@@ -0,0 +1,30 @@
+package proto
+
+import "fmt"
+
+// Livestream errors are returned as is, so callers can tell them apart with errors.As
+func (x EnumLiveStreamError) Error() string {
+	return fmt.Sprintf("livestream failed: %s", x.String())
+}
+
+// Return the livestream's error, or nil if it has none
+func (x *NotifyLiveStreamStatus) Err() error {
+	if x.GetLiveStreamError() == EnumLiveStreamError_LIVE_STREAM_ERROR_NONE {
+		return nil
+	}
+	return x.GetLiveStreamError()
+}
+
+// Configure the livestream, which starts once the shutter is turned on. Written to the command characteristic, answered with ResponseGeneric.
+// Unset optional fields are omitted, so the camera applies its own defaults, e.g. for the lens on cameras that cannot choose one.
+//
+// The URL and certificate are unbounded, so this errors if they cannot fit in a single message.
+func (a actionT) SetLiveStreamMode(r *RequestSetLiveStreamMode) ([][]byte, error) {
+	return buildAction(FeatureCommand, ActionSetLiveStreamMode, r)
+}
+
+// Get the livestream's status, optionally (un)registering for notifications when it changes. Written to the query characteristic, answered with NotifyLiveStreamStatus.
+func (a actionT) GetLiveStreamStatus(register []EnumRegisterLiveStreamStatus, unregister []EnumRegisterLiveStreamStatus) ([][]byte, error) {
+	r := &RequestGetLiveStreamStatus{RegisterLiveStreamStatus: register, UnregisterLiveStreamStatus: unregister}
+	return buildAction(FeatureQuery, ActionGetLiveStreamStatus, r)
+}
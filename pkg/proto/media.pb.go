@@ -0,0 +1,232 @@
+// media.proto/Open GoPro, Version 2.0 (C) Copyright 2021 GoPro, Inc. (http://gopro.com/OpenGoPro).
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: media.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// *
+// Get the last captured media filename
+//
+// Response: @ref ResponseLastCapturedMedia
+type RequestGetLastCapturedMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RequestGetLastCapturedMedia) Reset() {
+	*x = RequestGetLastCapturedMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_media_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestGetLastCapturedMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGetLastCapturedMedia) ProtoMessage() {}
+
+func (x *RequestGetLastCapturedMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGetLastCapturedMedia.ProtoReflect.Descriptor instead.
+func (*RequestGetLastCapturedMedia) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{0}
+}
+
+// *
+// The Last Captured Media
+//
+// Message is sent in response to a @ref RequestGetLastCapturedMedia.
+//
+// This contains the relative path of the last captured media starting from the DCIM directory on the SDCard. Depending
+// on the type of media captured, it will return:
+//
+// - The single media path for single photo/video media
+// - The path to the first captured media in the group for grouped media
+type ResponseLastCapturedMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *EnumResultGeneric `protobuf:"varint,1,opt,name=result,enum=open_gopro.EnumResultGeneric" json:"result,omitempty"` // Was the request successful?
+	Media  *Media             `protobuf:"bytes,2,opt,name=media" json:"media,omitempty"`                                      // Last captured media if result is RESULT_SUCCESS. Invalid if result is RESULT_RESOURCE_NOT_AVAILBLE.
+}
+
+func (x *ResponseLastCapturedMedia) Reset() {
+	*x = ResponseLastCapturedMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_media_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseLastCapturedMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseLastCapturedMedia) ProtoMessage() {}
+
+func (x *ResponseLastCapturedMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseLastCapturedMedia.ProtoReflect.Descriptor instead.
+func (*ResponseLastCapturedMedia) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResponseLastCapturedMedia) GetResult() EnumResultGeneric {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return EnumResultGeneric_RESULT_UNKNOWN
+}
+
+func (x *ResponseLastCapturedMedia) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+var File_media_proto protoreflect.FileDescriptor
+
+var file_media_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x1a, 0x16, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x73, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x22, 0x7b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x61, 0x73, 0x74,
+	0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x35, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x42, 0x2b, 0x5a,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x61, 0x74,
+	0x70, 0x69, 0x78, 0x33, 0x6c, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_media_proto_rawDescOnce sync.Once
+	file_media_proto_rawDescData = file_media_proto_rawDesc
+)
+
+func file_media_proto_rawDescGZIP() []byte {
+	file_media_proto_rawDescOnce.Do(func() {
+		file_media_proto_rawDescData = protoimpl.X.CompressGZIP(file_media_proto_rawDescData)
+	})
+	return file_media_proto_rawDescData
+}
+
+var file_media_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_media_proto_goTypes = []interface{}{
+	(*RequestGetLastCapturedMedia)(nil), // 0: open_gopro.RequestGetLastCapturedMedia
+	(*ResponseLastCapturedMedia)(nil),   // 1: open_gopro.ResponseLastCapturedMedia
+	(EnumResultGeneric)(0),              // 2: open_gopro.EnumResultGeneric
+	(*Media)(nil),                       // 3: open_gopro.Media
+}
+var file_media_proto_depIdxs = []int32{
+	2, // 0: open_gopro.ResponseLastCapturedMedia.result:type_name -> open_gopro.EnumResultGeneric
+	3, // 1: open_gopro.ResponseLastCapturedMedia.media:type_name -> open_gopro.Media
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_media_proto_init() }
+func file_media_proto_init() {
+	if File_media_proto != nil {
+		return
+	}
+	file_response_generic_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_media_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestGetLastCapturedMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_media_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseLastCapturedMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_media_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_media_proto_goTypes,
+		DependencyIndexes: file_media_proto_depIdxs,
+		MessageInfos:      file_media_proto_msgTypes,
+	}.Build()
+	File_media_proto = out.File
+	file_media_proto_rawDesc = nil
+	file_media_proto_goTypes = nil
+	file_media_proto_depIdxs = nil
+}
@@ -0,0 +1,97 @@
+package proto
+
+import (
+	"fmt"
+
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Return true once provisioning has either connected or failed, and will not change without another request
+func (x EnumProvisioning) Done() bool {
+	switch x {
+	case EnumProvisioning_PROVISIONING_UNKNOWN, EnumProvisioning_PROVISIONING_NEVER_STARTED, EnumProvisioning_PROVISIONING_STARTED:
+		return false
+	}
+	return true
+}
+
+// Return nil if provisioning connected, otherwise an error naming the state
+func (x EnumProvisioning) Err() error {
+	switch x {
+	case EnumProvisioning_PROVISIONING_SUCCESS_NEW_AP, EnumProvisioning_PROVISIONING_SUCCESS_OLD_AP:
+		return nil
+	}
+	return &ProvisioningError{State: x}
+}
+
+// Returned when the camera fails to connect to an access point
+type ProvisioningError struct {
+	State EnumProvisioning
+}
+
+func (e *ProvisioningError) Error() string {
+	return fmt.Sprintf("connecting to access point failed: %v", e.State)
+}
+
+// Return true if every flag in "flag" is set on the access point, e.g. EnumScanEntryFlags_SCAN_FLAG_CONFIGURED
+func (x *ResponseGetApEntries_ScanEntry) HasFlag(flag EnumScanEntryFlags) bool {
+	return x.GetScanEntryFlags()&int32(flag) == int32(flag)
+}
+
+// Longest SSID and passphrase allowed by 802.11
+const (
+	MaxSSIDLength     = 32
+	MaxPasswordLength = 63
+)
+
+func checkSSID(ssid string) error {
+	if len(ssid) == 0 || len(ssid) > MaxSSIDLength {
+		return fmt.Errorf("SSID length %d is not between 1 and %d", len(ssid), MaxSSIDLength)
+	}
+	return nil
+}
+
+// Start scanning for access points. Written to the network management characteristic, answered with ResponseStartScanning, then NotifStartScanning as the scan progresses.
+func (a actionT) StartScan() ([][]byte, error) {
+	return buildAction(FeatureNetworkManagement, ActionStartScan, &RequestStartScan{})
+}
+
+// Get up to "max" access points found by the scan "scanID", starting at index "start". Written to the network management characteristic, answered with ResponseGetApEntries.
+func (a actionT) GetAccessPointEntries(scanID int32, start int32, max int32) ([][]byte, error) {
+	r := &RequestGetApEntries{StartIndex: protobuf.Int32(start), MaxEntries: protobuf.Int32(max), ScanId: protobuf.Int32(scanID)}
+	return buildAction(FeatureNetworkManagement, ActionGetApEntries, r)
+}
+
+// Connect to an access point the camera already knows. Written to the network management characteristic, answered with ResponseConnect, then NotifProvisioningState as the camera connects.
+func (a actionT) Connect(ssid string) ([][]byte, error) {
+
+	if err := checkSSID(ssid); err != nil {
+		return nil, err
+	}
+
+	r := &RequestConnect{Ssid: protobuf.String(ssid)}
+	return buildAction(FeatureNetworkManagement, ActionConnect, r)
+
+}
+
+// Connect to an access point the camera does not know yet. Written to the network management characteristic, answered with ResponseConnectNew, then NotifProvisioningState as the camera connects.
+// The static IP fields are optional, the camera uses DHCP if they are left empty.
+func (a actionT) ConnectNew(r *RequestConnectNew) ([][]byte, error) {
+
+	if err := checkSSID(r.GetSsid()); err != nil {
+		return nil, err
+	}
+
+	if len(r.GetPassword()) > MaxPasswordLength {
+		return nil, fmt.Errorf("password length %d exceeds maximum of %d", len(r.GetPassword()), MaxPasswordLength)
+	}
+
+	for _, v := range [][]byte{r.StaticIp, r.Gateway, r.Subnet, r.DnsPrimary, r.DnsSecondary} {
+		if len(v) > 16 {
+			return nil, fmt.Errorf("address length %d exceeds maximum of 16", len(v))
+		}
+	}
+
+	return buildAction(FeatureNetworkManagement, ActionConnectNew, r)
+
+}
@@ -0,0 +1,45 @@
+package proto
+
+// Return the preset group "id", and whether it is available
+func (x *NotifyPresetStatus) Group(id EnumPresetGroup) (*PresetGroup, bool) {
+	for _, g := range x.GetPresetGroupArray() {
+		if g.GetId() == id {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// Return the preset "id" from whichever group holds it, and whether it is available
+func (x *NotifyPresetStatus) Preset(id int32) (*Preset, bool) {
+	for _, g := range x.GetPresetGroupArray() {
+		for _, p := range g.GetPresetArray() {
+			if p.GetId() == id {
+				return p, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Return every available preset, in group order
+func (x *NotifyPresetStatus) Presets() []*Preset {
+	var presets []*Preset
+	for _, g := range x.GetPresetGroupArray() {
+		presets = append(presets, g.GetPresetArray()...)
+	}
+	return presets
+}
+
+// Get every available preset, grouped by preset group, optionally (un)registering for notifications when they change. Written to the query characteristic, answered with NotifyPresetStatus.
+func (a actionT) GetPresetStatus(register []EnumRegisterPresetStatus, unregister []EnumRegisterPresetStatus) ([][]byte, error) {
+	r := &RequestGetPresetStatus{RegisterPresetStatus: register, UnregisterPresetStatus: unregister}
+	return buildAction(FeatureQuery, ActionGetPresetStatus, r)
+}
+
+// Update the title and icon of the active custom preset, leaving unset fields unchanged. Written to the command characteristic, answered with ResponseGeneric.
+//
+// Custom presets can only be created and deleted from the camera's UI, the BLE API has no operation for either.
+func (a actionT) UpdateCustomPreset(r *RequestCustomPresetUpdate) ([][]byte, error) {
+	return buildAction(FeatureCommand, ActionUpdateCustomPreset, r)
+}
@@ -0,0 +1,125 @@
+// Utilities for building the protobuf operations introduced by newer Open GoPro versions, as well as (un)marshaling their responses and notifications.
+//
+// The messages are generated by protoc-gen-go from the Open GoPro .proto definitions vendored alongside this package.
+package proto
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative cohn.proto live_streaming.proto media.proto network_management.proto preset_status.proto request_get_preset_status.proto response_generic.proto set_camera_control_status.proto turbo_transfer.proto
+
+import (
+	"fmt"
+
+	"github.com/thatpix3l/persephone/pkg/packet"
+	"github.com/thatpix3l/persephone/pkg/transport"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	Action actionT = iota // Root of all functions for generating protobuf byte sequences
+)
+
+type actionT int
+
+// Feature IDs, the first byte of every protobuf message
+const (
+	FeatureCommand byte = 0xf1 // Written to the command characteristic, answered on the command response characteristic
+	FeatureQuery   byte = 0xf5 // Written to the query characteristic, answered on the query response characteristic
+
+	FeatureNetworkManagement byte = 0x02 // Written to the network management characteristic, answered on the network management response characteristic
+)
+
+// Action IDs, the second byte of every protobuf message
+const (
+	ActionUpdateCustomPreset     byte = 0x64
+	ActionSetCOHNSetting         byte = 0x65
+	ActionClearCOHNCert          byte = 0x66
+	ActionCreateCOHNCert         byte = 0x67
+	ActionSetCameraControlStatus byte = 0x69
+	ActionSetTurboActive         byte = 0x6b
+	ActionGetLastCapturedMedia   byte = 0x6d
+	ActionGetCOHNCert            byte = 0x6e
+	ActionGetCOHNStatus          byte = 0x6f
+	ActionGetPresetStatus        byte = 0x72
+	ActionGetLiveStreamStatus    byte = 0x74
+	ActionSetLiveStreamMode      byte = 0x79
+)
+
+// Action IDs of the network management feature
+const (
+	ActionStartScan    byte = 0x02
+	ActionGetApEntries byte = 0x03
+	ActionConnect      byte = 0x04
+	ActionConnectNew   byte = 0x05
+)
+
+// Action IDs of notifications the camera pushes without being asked, after registering for them or starting the operation they report on
+const (
+	ActionNotifyPresetStatus      byte = 0xf3
+	ActionNotifyLiveStreamStatus  byte = 0xf5
+	ActionNotifyCOHNStatus        byte = 0xef // Shares its action ID with the response to ActionGetCOHNStatus
+	ActionNotifyStartScanning     byte = 0x0b
+	ActionNotifyProvisioningState byte = 0x0c
+)
+
+// Return the action ID the camera answers the request with action ID "action" with
+func ResponseAction(action byte) byte {
+	return action | 0x80
+}
+
+// Return true if a message on "channel" starting with "id" is a protobuf message with "id" as its feature ID.
+// Network management messages always are, while commands and queries only are when "id" is their channel's feature ID, as the rest are TLV messages whose IDs may overlap FeatureNetworkManagement.
+func IsFeature(channel transport.Channel, id byte) bool {
+	switch channel {
+	case transport.NetworkManagement:
+		return id == FeatureNetworkManagement
+	case transport.Command:
+		return id == FeatureCommand
+	case transport.Query:
+		return id == FeatureQuery
+	}
+	return false
+}
+
+// Build the message for "action" of "feature" carrying the request "m", framed into packets ready to be written to the feature's characteristic
+func buildAction(feature byte, action byte, m protobuf.Message) ([][]byte, error) {
+
+	payload, err := protobuf.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return packet.Frame(append([]byte{feature, action}, payload...))
+
+}
+
+// Split a complete protobuf message, as reassembled by packet.Accumulator, into its feature ID, action ID and encoded protobuf payload
+func Split(message []byte) (byte, byte, []byte, error) {
+
+	if len(message) < 2 {
+		return 0, 0, nil, fmt.Errorf("byte array length %d is less than minimum of 2: %v", len(message), message)
+	}
+
+	switch message[0] {
+	case FeatureCommand, FeatureQuery, FeatureNetworkManagement:
+	default:
+		return 0, 0, nil, fmt.Errorf("feature ID %#x is not a protobuf feature: %v", message[0], message)
+	}
+
+	return message[0], message[1], message[2:], nil
+
+}
+
+// Decode the payload of "message", a complete protobuf message, into "m", after checking it carries the expected feature and action IDs
+func Unmarshal(message []byte, feature byte, action byte, m protobuf.Message) error {
+
+	gotFeature, gotAction, payload, err := Split(message)
+	if err != nil {
+		return err
+	}
+
+	if gotFeature != feature || gotAction != action {
+		return fmt.Errorf("message is for feature %#x action %#x, expected feature %#x action %#x", gotFeature, gotAction, feature, action)
+	}
+
+	return protobuf.Unmarshal(payload, m)
+
+}
@@ -0,0 +1,75 @@
+package query
+
+import (
+	"github.com/thatpix3l/persephone/pkg/packet"
+	"github.com/thatpix3l/persephone/pkg/settings"
+	"github.com/thatpix3l/persephone/pkg/tlv"
+)
+
+const (
+	Action actionT = iota // Root of all functions for generating query byte sequences
+)
+
+type actionT int
+
+// ID of a single setting, as referred to by setting queries
+type SettingID = settings.ID
+
+// Query IDs, shared by a query and its response
+const (
+	IDGetSettingValues         byte = settings.QueryIDGetSettingValues       // Get setting values
+	IDGetStatusValues          byte = 0x13                                   // Get status values
+	IDGetSettingCapabilities   byte = 0x32                                   // Get setting capabilities
+	IDRegisterSettingUpdates   byte = settings.QueryIDRegisterSettingUpdates // Register for setting value updates
+	IDRegisterStatusUpdates    byte = 0x53                                   // Register for status value updates
+	IDUnregisterSettingUpdates byte = 0x72                                   // Unregister for setting value updates
+	IDUnregisterStatusUpdates  byte = 0x73                                   // Unregister for status value updates
+	IDSettingUpdate            byte = settings.QueryIDSettingUpdate          // Asynchronous setting value update
+	IDStatusUpdate             byte = 0x93                                   // Asynchronous status value update
+)
+
+// Build the message for query "id" over the given status or setting IDs, framed into packets ready to be written to the query characteristic
+func buildAction(id byte, ids ...byte) ([][]byte, error) {
+	return packet.Frame(append([]byte{id}, ids...))
+}
+
+// Get the values of the given statuses
+func (a actionT) GetStatusValues(ids ...StatusID) ([][]byte, error) {
+	return buildAction(IDGetStatusValues, tlv.IDs(ids)...)
+}
+
+// Get the values of every status
+func (a actionT) GetAllStatusValues() ([][]byte, error) {
+	return buildAction(IDGetStatusValues)
+}
+
+// Get the values of the given settings
+func (a actionT) GetSettingValues(ids ...SettingID) ([][]byte, error) {
+	return buildAction(IDGetSettingValues, tlv.IDs(ids)...)
+}
+
+// Get the values of every setting
+func (a actionT) GetAllSettingValues() ([][]byte, error) {
+	return buildAction(IDGetSettingValues)
+}
+
+// Get the values currently allowed for the given settings, or for every setting if none are given
+func (a actionT) GetSettingCapabilities(ids ...SettingID) ([][]byte, error) {
+	return buildAction(IDGetSettingCapabilities, tlv.IDs(ids)...)
+}
+
+func (a actionT) RegisterStatusUpdates(ids ...StatusID) ([][]byte, error) {
+	return buildAction(IDRegisterStatusUpdates, tlv.IDs(ids)...)
+}
+
+func (a actionT) UnregisterStatusUpdates(ids ...StatusID) ([][]byte, error) {
+	return buildAction(IDUnregisterStatusUpdates, tlv.IDs(ids)...)
+}
+
+func (a actionT) RegisterSettingUpdates(ids ...SettingID) ([][]byte, error) {
+	return buildAction(IDRegisterSettingUpdates, tlv.IDs(ids)...)
+}
+
+func (a actionT) UnregisterSettingUpdates(ids ...SettingID) ([][]byte, error) {
+	return buildAction(IDUnregisterSettingUpdates, tlv.IDs(ids)...)
+}
@@ -0,0 +1,267 @@
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/c2h5oh/datasize"
+)
+
+// Multiplier of every unit a status value can be tagged with, converting from the unit the camera reports in to the field's own
+var units = map[string]uint64{
+	"ms":  uint64(time.Millisecond),
+	"s":   uint64(time.Second),
+	"min": uint64(time.Minute),
+	"B":   uint64(datasize.B),
+	"KB":  uint64(datasize.KB),
+	"MB":  uint64(datasize.MB),
+}
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	byteSizeType = reflect.TypeOf(datasize.ByteSize(0))
+)
+
+// Describes how a single status maps onto a field of Response, as declared by the field's tags
+type Field struct {
+	ID    StatusID // From the "queryID" tag
+	Name  string   // Name of the field in Response
+	Unit  string   // From the "unit" tag, the unit the camera reports the value in, if any
+	Width int      // From the "width" tag, the most bytes the camera reports the value in, 0 for strings
+
+	index      int          // Index of the field in Response
+	multiplier uint64       // Multiplier of Unit, 1 if none
+	kind       reflect.Kind // Kind of the field
+}
+
+// Every status in Response, indexed by ID and in ascending order of ID
+var (
+	fieldsByID = map[StatusID]Field{}
+	fieldList  = []Field{}
+)
+
+func init() {
+
+	t := reflect.TypeOf(Response{})
+
+	for i := 0; i < t.NumField(); i++ {
+
+		structField := t.Field(i)
+		field, err := newField(i, structField)
+		if err != nil {
+			// Tags are fixed at compile time, so a bad one is a bug in Response itself
+			panic(fmt.Sprintf("query.Response.%s: %v", structField.Name, err))
+		}
+
+		if _, ok := fieldsByID[field.ID]; ok {
+			panic(fmt.Sprintf("query.Response.%s: status ID %d is declared more than once", structField.Name, field.ID))
+		}
+
+		fieldsByID[field.ID] = field
+		fieldList = append(fieldList, field)
+
+	}
+
+	sort.Slice(fieldList, func(i, j int) bool { return fieldList[i].ID < fieldList[j].ID })
+
+}
+
+// Build and validate the description of a single field of Response from its tags
+func newField(index int, structField reflect.StructField) (Field, error) {
+
+	tag, ok := structField.Tag.Lookup("queryID")
+	if !ok {
+		return Field{}, fmt.Errorf("missing queryID tag")
+	}
+
+	id, err := strconv.ParseUint(tag, 10, 8)
+	if err != nil {
+		return Field{}, fmt.Errorf("queryID tag %q is not a byte: %w", tag, err)
+	}
+
+	field := Field{
+		ID:         StatusID(id),
+		Name:       structField.Name,
+		Unit:       structField.Tag.Get("unit"),
+		index:      index,
+		multiplier: 1,
+		kind:       structField.Type.Kind(),
+	}
+
+	switch field.kind {
+
+	case reflect.String:
+		if _, ok := structField.Tag.Lookup("width"); ok {
+			return Field{}, fmt.Errorf("strings cannot have a width tag")
+		}
+
+	default:
+		width, err := strconv.Atoi(structField.Tag.Get("width"))
+		if err != nil || width < 1 || width > 8 {
+			return Field{}, fmt.Errorf("width tag %q is not a whole number of bytes between 1 and 8", structField.Tag.Get("width"))
+		}
+		field.Width = width
+
+	}
+
+	if field.Unit != "" {
+		multiplier, ok := units[field.Unit]
+		if !ok {
+			return Field{}, fmt.Errorf("unit %q does not exist", field.Unit)
+		}
+		field.multiplier = multiplier
+	}
+
+	// Durations and byte sizes are meaningless without knowing what the camera counts in
+	if (structField.Type == durationType || structField.Type == byteSizeType) && field.Unit == "" {
+		return Field{}, fmt.Errorf("%v requires a unit tag", structField.Type)
+	}
+
+	switch field.kind {
+	case reflect.Bool, reflect.String,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+	default:
+		return Field{}, fmt.Errorf("kind %v is not supported", field.kind)
+	}
+
+	return field, nil
+
+}
+
+// Return every status in Response, in ascending order of ID
+func Fields() []Field {
+	return append([]Field{}, fieldList...)
+}
+
+// Return the status with the given ID, and whether it exists in Response
+func FieldByID(id StatusID) (Field, bool) {
+	field, ok := fieldsByID[id]
+	return field, ok
+}
+
+// Return true if "kind" is a signed int
+func isSigned(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// Convert a Big-Endian two's complement byte slice of at most 8 bytes to a signed int, sign extending from its own length
+func bytesToInt(b []byte) int64 {
+	if len(b) == 0 {
+		return 0
+	}
+	shift := 64 - 8*len(b)
+	return int64(bytesToUint(b)<<shift) >> shift
+}
+
+// Decode "valBytes" into "v", the field of Response described by "f"
+func (f Field) decode(v reflect.Value, valBytes []byte) error {
+
+	if f.kind == reflect.String {
+		v.SetString(string(valBytes))
+		return nil
+	}
+
+	if len(valBytes) == 0 {
+		return fmt.Errorf("value is empty")
+	}
+
+	if len(valBytes) > f.Width {
+		return fmt.Errorf("value count of %d exceeds declared width of %d: %v", len(valBytes), f.Width, valBytes)
+	}
+
+	switch {
+
+	case f.kind == reflect.Bool:
+		valUint := uint64(bytesToUint(valBytes))
+		if valUint > 1 {
+			return fmt.Errorf("number is not 0 or 1: \"%v\"", valUint)
+		}
+		v.SetBool(valUint == 1)
+
+	case isSigned(f.kind):
+		valInt := bytesToInt(valBytes)
+		val := valInt * int64(f.multiplier)
+		if valInt != 0 && val/valInt != int64(f.multiplier) || v.OverflowInt(val) {
+			return fmt.Errorf("value %d %s overflows %v", valInt, f.Unit, v.Type())
+		}
+		v.SetInt(val)
+
+	default:
+		valUint := uint64(bytesToUint(valBytes))
+		val := valUint * f.multiplier
+		if valUint != 0 && val/valUint != f.multiplier || v.OverflowUint(val) {
+			return fmt.Errorf("value %d %s overflows %v", valUint, f.Unit, v.Type())
+		}
+		v.SetUint(val)
+
+	}
+
+	return nil
+
+}
+
+// Return "val" as "width" Big-Endian bytes, erroring if it does not fit
+func uintToBytes(val uint64, width int) ([]byte, error) {
+
+	if width < 8 && val>>(8*width) != 0 {
+		return nil, fmt.Errorf("value %d exceeds declared width of %d", val, width)
+	}
+
+	buf := make([]byte, width)
+	for i := range buf {
+		buf[width-1-i] = byte(val >> (8 * i))
+	}
+
+	return buf, nil
+
+}
+
+// Return "val" as "width" Big-Endian two's complement bytes, erroring if it does not fit
+func intToBytes(val int64, width int) ([]byte, error) {
+
+	if width < 8 && (val < -(1<<(8*width-1)) || val >= 1<<(8*width-1)) {
+		return nil, fmt.Errorf("value %d exceeds declared width of %d", val, width)
+	}
+
+	buf := make([]byte, width)
+	for i := range buf {
+		buf[width-1-i] = byte(val >> (8 * i))
+	}
+
+	return buf, nil
+
+}
+
+// Encode "v", the field of Response described by "f", back into the value bytes the camera would report
+func (f Field) encode(v reflect.Value) ([]byte, error) {
+
+	switch {
+
+	case f.kind == reflect.Bool:
+		if v.Bool() {
+			return uintToBytes(1, f.Width)
+		}
+		return uintToBytes(0, f.Width)
+
+	case f.kind == reflect.String:
+		if v.Len() > 0xff {
+			return nil, fmt.Errorf("string length %d is more than maximum of 255", v.Len())
+		}
+		return []byte(v.String()), nil
+
+	case isSigned(f.kind):
+		return intToBytes(v.Int()/int64(f.multiplier), f.Width)
+
+	}
+
+	return uintToBytes(v.Uint()/f.multiplier, f.Width)
+
+}
@@ -0,0 +1,56 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Marshal a single status of "r" into a Big-Endian encoded byte array consisting of [status_ID, count_of_values, val_1, val2, ...], the inverse of UnmarshalPartial.
+func MarshalPartial(r *Response, id StatusID) ([]byte, error) {
+
+	if r == nil {
+		return nil, errors.New("response is nil")
+	}
+
+	field, ok := fieldsByID[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownStatus, id)
+	}
+
+	valBytes, err := field.encode(reflect.ValueOf(r).Elem().Field(field.index))
+	if err != nil {
+		return nil, fmt.Errorf("status ID %d: %w", id, err)
+	}
+
+	return append([]byte{byte(id), byte(len(valBytes))}, valBytes...), nil
+
+}
+
+// Marshal the given statuses of "r", or every status if none are given, into consecutive [status_ID, count_of_values, val_1, val2, ...] triples, as found after the query ID and status of a full GoPro Query Response.
+//
+// Strings are encoded as their raw bytes, and everything else in exactly its declared width, with durations and byte sizes converted back into the unit the camera reports them in.
+// Errors if a value does not fit its declared width.
+func Marshal(r *Response, ids ...StatusID) ([]byte, error) {
+
+	if len(ids) == 0 {
+		for _, field := range fieldList {
+			ids = append(ids, field.ID)
+		}
+	}
+
+	data := []byte{}
+	for _, id := range ids {
+
+		partial, err := MarshalPartial(r, id)
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, partial...)
+
+	}
+
+	return data, nil
+
+}
@@ -0,0 +1,122 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/c2h5oh/datasize"
+)
+
+func TestMarshalRoundTrip(t *testing.T) {
+
+	want := Response{
+		HasInternalBattery:         true,
+		BatteryLevelBars:           3,
+		VideoProgressCounter:       123456,
+		TimeSinceSuccessfulPairing: 1500 * time.Millisecond,
+		WlanApSsid:                 "home",
+		CameraApSsid:               "GP12345678",
+		StorageStatus:              StorageStatusUnknown,
+		VideoTimeBeforeFull:        90 * time.Minute,
+		RemainingSpace:             64 * datasize.GB,
+		Orientation:                OrientationOnLeftSide,
+		PresetID:                   0xdeadbeef,
+		TotalStorageSpace:          128 * datasize.GB,
+	}
+
+	data, err := Marshal(&want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Response
+	report, err := Unmarshal(append([]byte{IDGetStatusValues, 0}, data...), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !report.OK() {
+		t.Errorf("report has unknown statuses %v and malformed statuses %v", report.Unknown, report.Malformed)
+	}
+	if len(report.Present) != len(Fields()) {
+		t.Errorf("decoded %d statuses, want every one of %d", len(report.Present), len(Fields()))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+}
+
+func TestMarshalPartial(t *testing.T) {
+
+	r := Response{BatteryLevelBars: 2, VideoProgressCounter: 0x01020304, WlanApSsid: "ab"}
+
+	tests := []struct {
+		id   StatusID
+		want []byte
+	}{
+		{2, []byte{2, 1, 2}},
+		{13, []byte{13, 4, 1, 2, 3, 4}},
+		{29, []byte{29, 2, 'a', 'b'}},
+		{30, []byte{30, 0}},
+	}
+
+	for _, test := range tests {
+		got, err := MarshalPartial(&r, test.id)
+		if err != nil {
+			t.Errorf("status %d: %v", test.id, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("status %d = %v, want %v", test.id, got, test.want)
+		}
+	}
+
+}
+
+func TestMarshalErrors(t *testing.T) {
+
+	if _, err := Marshal(&Response{}, 0); err == nil {
+		t.Error("marshaling an unknown status did not error")
+	}
+
+	// Does not fit the single byte the camera reports it in
+	if _, err := Marshal(&Response{BatteryLevelBars: 256}, 2); err == nil {
+		t.Error("marshaling a value wider than its status did not error")
+	}
+
+}
+
+func TestUnmarshalReport(t *testing.T) {
+
+	// Known, empty string, unknown, too wide for its status, empty number, then truncated
+	data := []byte{IDGetStatusValues, 0, 2, 1, 3, 30, 0, 250, 1, 0, 1, 2, 0, 1, 4, 0, 70, 4}
+
+	var r Response
+	report, err := Unmarshal(data, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(report.Present, []StatusID{2, 30}) || r.BatteryLevelBars != 3 {
+		t.Errorf("present %v with %d bars, want [2 30] with 3 bars", report.Present, r.BatteryLevelBars)
+	}
+	if !reflect.DeepEqual(report.Unknown, []StatusID{250}) {
+		t.Errorf("unknown %v, want [250]", report.Unknown)
+	}
+	if _, ok := report.Malformed[1]; !ok {
+		t.Error("status 1 is not malformed")
+	}
+	if _, ok := report.Malformed[4]; !ok {
+		t.Error("empty status 4 is not malformed")
+	}
+	if _, ok := report.Malformed[70]; !ok {
+		t.Error("truncated status 70 is not malformed")
+	}
+
+	if _, err := Unmarshal([]byte{IDGetStatusValues, 1}, &r); err == nil {
+		t.Error("a failed query did not error")
+	}
+
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/c2h5oh/datasize"
@@ -12,91 +13,91 @@ import (
 )
 
 type Response struct {
-	HasInternalBattery               bool              // `queryID:"1"`
-	BatteryLevelBars                 uint              // `queryID:"2"`
-	HasExternalBattery               bool              // `queryID:"3"`
-	ExternalBatteryPercent           uint              // `queryID:"4"`
-	IsOverHeating                    bool              // `queryID:"6"`
-	IsBusy                           bool              // `queryID:"8"`
-	IsQuickCaptureEnabled            bool              // `queryID:"9"`
-	IsEncoding                       bool              // `queryID:"10"`
-	IsLcdLockActive                  bool              // `queryID:"11"`
-	VideoProgressCounter             uint              // `queryID:"13"`
-	IsWirelessConnectionsEnabled     bool              // `queryID:"17"`
-	PairingStatus                    uint              // `queryID:"19"`
-	PairingType                      uint              // `queryID:"20"`
-	TimeSinceSuccessfulPairing       time.Duration     // `queryID:"21"`
-	WifiScanStatus                   uint              // `queryID:"22"`
-	TimeSinceCompletedWifiScan       time.Duration     // `queryID:"23"`
-	WifiProvisionStatus              uint              // `queryID:"24"`
-	RemoteControlVersion             uint              // `queryID:"26"`
-	IsRemoteControlConnected         bool              // `queryID:"27"`
-	WirelessPairingStatus            uint              // `queryID:"28"`
-	WlanApSsid                       string            // `queryID:"29"`
-	CameraApSsid                     string            // `queryID:"30"`
-	WirelessDeviceCount              uint              // `queryID:"31"`
-	IsPreviewStreamEnabled           bool              // `queryID:"32"`
-	StorageStatus                    int               // `queryID:"33"`
-	PhotosBeforeFull                 uint              // `queryID:"34"`
-	VideoTimeBeforeFull              time.Duration     // `queryID:"35"`
-	GroupPhotosBeforeFull            uint              // `queryID:"36"`
-	TotalGroupVideos                 uint              // `queryID:"37"`
-	TotalPhotos                      uint              // `queryID:"38"`
-	TotalVideos                      uint              // `queryID:"39"`
-	UpdateStatus                     uint              // `queryID:"41"`
-	IsCancellingUpdate               bool              // `queryID:"42"`
-	IsLocateCameraActive             bool              // `queryID:"45"`
-	MultishotCountdown               uint              // `queryID:"49"`
-	RemainingSpace                   datasize.ByteSize // `queryID:"54"`
-	IsPreviewStreamSupported         bool              // `queryID:"55"`
-	WifiBarStrentgh                  uint              // `queryID:"56"`
-	TagHilightsCount                 uint              // `queryID:"58"`
-	TimeSinceBootTagHilight          time.Duration     // `queryID:"59"`
-	StatusUpdateMinIntervalMS        uint              // `queryID:"60"`
-	TimelapseTimeBeforeFull          time.Duration     // `queryID:"64"`
-	ExposureMode                     uint              // `queryID:"65"`
-	ExposureX                        uint              // `queryID:"66"`
-	ExposureY                        uint              // `queryID:"67"`
-	IsGpsLocked                      bool              // `queryID:"68"`
-	IsWifiRadioEnabled               bool              // `queryID:"69"`
-	InternalBatteryPercent           uint              // `queryID:"70"`
-	MicAccessoryStatus               uint              // `queryID:"74"`
-	DigitalZoomPercent               uint              // `queryID:"75"`
-	WifiBandMode                     uint              // `queryID:"76"`
-	IsDigitalZoomActive              bool              // `queryID:"77"`
-	IsVideoSettingsMobileFriendly    bool              // `queryID:"78"`
-	IsFirstTimeMode                  bool              // `queryID:"79"`
-	IsWifi5GHzBandAvailable          bool              // `queryID:"81"`
-	IsReadyForCommands               bool              // `queryID:"82"`
-	IsBatteryGoodForUpdates          bool              // `queryID:"83"`
-	IsTooCold                        bool              // `queryID:"85"`
-	Orientation                      uint              // `queryID:"86"`
-	IsZoomableWhileEncoding          bool              // `queryID:"88"`
-	FlatMode                         uint              // `queryID:"89"`
-	VideoPresetID                    uint              // `queryID:"93"`
-	PhotoPresetID                    uint              // `queryID:"94"`
-	TimelapsePresetID                uint              // `queryID:"95"`
-	PresetGroupID                    uint              // `queryID:"96"`
-	PresetID                         uint              // `queryID:"97"`
-	PresetModified                   uint              // `queryID:"98"`
-	LiveBurstsBeforeFull             uint              // `queryID:"99"`
-	LiveBursts                       uint              // `queryID:"100"`
-	IsCaptureDelayCountingDown       bool              // `queryID:"101"`
-	MediaModeStatus                  uint              // `queryID:"102"`
-	TimeWarpSpeed                    uint              // `queryID:"103"`
-	IsLinuxCoreActive                bool              // `queryID:"104"`
-	CameraLensType                   uint              // `queryID:"105"`
-	IsVideoHindsightCaptureActive    bool              // `queryID:"106"`
-	ScheduledCapturePresetID         uint              // `queryID:"107"`
-	IsScheduledCaptureSet            bool              // `queryID:"108"`
-	MediaModeStatusBitmasked         uint              // `queryID:"110"`
-	HasStorageMinimumWriteSpeed      bool              // `queryID:"111"`
-	StorageWriteSpeedErrorsSinceBoot uint              // `queryID:"112"`
-	IsTurboTransferActive            bool              // `queryID:"113"`
-	CameraControlStatus              uint              // `queryID:"114"`
-	IsConnectedViaUSB                bool              // `queryID:"115"`
-	UsbControlStaus                  uint              // `queryID:"116"`
-	TotalStorageSpace                datasize.ByteSize // `queryID:"117"`
+	HasInternalBattery               bool                `queryID:"1" width:"1"`
+	BatteryLevelBars                 uint                `queryID:"2" width:"1"`
+	HasExternalBattery               bool                `queryID:"3" width:"1"`
+	ExternalBatteryPercent           uint                `queryID:"4" width:"1"`
+	IsOverHeating                    bool                `queryID:"6" width:"1"`
+	IsBusy                           bool                `queryID:"8" width:"1"`
+	IsQuickCaptureEnabled            bool                `queryID:"9" width:"1"`
+	IsEncoding                       bool                `queryID:"10" width:"1"`
+	IsLcdLockActive                  bool                `queryID:"11" width:"1"`
+	VideoProgressCounter             uint                `queryID:"13" width:"4"`
+	IsWirelessConnectionsEnabled     bool                `queryID:"17" width:"1"`
+	PairingStatus                    PairingStatus       `queryID:"19" width:"1"`
+	PairingType                      PairingType         `queryID:"20" width:"1"`
+	TimeSinceSuccessfulPairing       time.Duration       `queryID:"21" width:"4" unit:"ms"`
+	WifiScanStatus                   WifiScanStatus      `queryID:"22" width:"1"`
+	TimeSinceCompletedWifiScan       time.Duration       `queryID:"23" width:"4" unit:"ms"`
+	WifiProvisionStatus              WifiProvisionStatus `queryID:"24" width:"1"`
+	RemoteControlVersion             uint                `queryID:"26" width:"1"`
+	IsRemoteControlConnected         bool                `queryID:"27" width:"1"`
+	WirelessPairingStatus            uint                `queryID:"28" width:"1"`
+	WlanApSsid                       string              `queryID:"29"`
+	CameraApSsid                     string              `queryID:"30"`
+	WirelessDeviceCount              uint                `queryID:"31" width:"1"`
+	IsPreviewStreamEnabled           bool                `queryID:"32" width:"1"`
+	StorageStatus                    StorageStatus       `queryID:"33" width:"1"`
+	PhotosBeforeFull                 uint                `queryID:"34" width:"4"`
+	VideoTimeBeforeFull              time.Duration       `queryID:"35" width:"4" unit:"min"`
+	GroupPhotosBeforeFull            uint                `queryID:"36" width:"4"`
+	TotalGroupVideos                 uint                `queryID:"37" width:"4"`
+	TotalPhotos                      uint                `queryID:"38" width:"4"`
+	TotalVideos                      uint                `queryID:"39" width:"4"`
+	UpdateStatus                     uint                `queryID:"41" width:"1"`
+	IsCancellingUpdate               bool                `queryID:"42" width:"1"`
+	IsLocateCameraActive             bool                `queryID:"45" width:"1"`
+	MultishotCountdown               uint                `queryID:"49" width:"1"`
+	RemainingSpace                   datasize.ByteSize   `queryID:"54" width:"8" unit:"KB"`
+	IsPreviewStreamSupported         bool                `queryID:"55" width:"1"`
+	WifiBarStrentgh                  uint                `queryID:"56" width:"1"`
+	TagHilightsCount                 uint                `queryID:"58" width:"4"`
+	TimeSinceBootTagHilight          time.Duration       `queryID:"59" width:"4" unit:"ms"`
+	StatusUpdateMinIntervalMS        uint                `queryID:"60" width:"4"`
+	TimelapseTimeBeforeFull          time.Duration       `queryID:"64" width:"4" unit:"min"`
+	ExposureMode                     ExposureMode        `queryID:"65" width:"1"`
+	ExposureX                        uint                `queryID:"66" width:"1"`
+	ExposureY                        uint                `queryID:"67" width:"1"`
+	IsGpsLocked                      bool                `queryID:"68" width:"1"`
+	IsWifiRadioEnabled               bool                `queryID:"69" width:"1"`
+	InternalBatteryPercent           uint                `queryID:"70" width:"1"`
+	MicAccessoryStatus               MicAccessoryStatus  `queryID:"74" width:"1"`
+	DigitalZoomPercent               uint                `queryID:"75" width:"1"`
+	WifiBandMode                     WifiBandMode        `queryID:"76" width:"1"`
+	IsDigitalZoomActive              bool                `queryID:"77" width:"1"`
+	IsVideoSettingsMobileFriendly    bool                `queryID:"78" width:"1"`
+	IsFirstTimeMode                  bool                `queryID:"79" width:"1"`
+	IsWifi5GHzBandAvailable          bool                `queryID:"81" width:"1"`
+	IsReadyForCommands               bool                `queryID:"82" width:"1"`
+	IsBatteryGoodForUpdates          bool                `queryID:"83" width:"1"`
+	IsTooCold                        bool                `queryID:"85" width:"1"`
+	Orientation                      Orientation         `queryID:"86" width:"1"`
+	IsZoomableWhileEncoding          bool                `queryID:"88" width:"1"`
+	FlatMode                         FlatMode            `queryID:"89" width:"1"`
+	VideoPresetID                    uint                `queryID:"93" width:"4"`
+	PhotoPresetID                    uint                `queryID:"94" width:"4"`
+	TimelapsePresetID                uint                `queryID:"95" width:"4"`
+	PresetGroupID                    uint                `queryID:"96" width:"4"`
+	PresetID                         uint                `queryID:"97" width:"4"`
+	PresetModified                   uint                `queryID:"98" width:"4"`
+	LiveBurstsBeforeFull             uint                `queryID:"99" width:"4"`
+	LiveBursts                       uint                `queryID:"100" width:"4"`
+	IsCaptureDelayCountingDown       bool                `queryID:"101" width:"1"`
+	MediaModeStatus                  MediaModeStatus     `queryID:"102" width:"1"`
+	TimeWarpSpeed                    TimeWarpSpeed       `queryID:"103" width:"1"`
+	IsLinuxCoreActive                bool                `queryID:"104" width:"1"`
+	CameraLensType                   CameraLensType      `queryID:"105" width:"1"`
+	IsVideoHindsightCaptureActive    bool                `queryID:"106" width:"1"`
+	ScheduledCapturePresetID         uint                `queryID:"107" width:"4"`
+	IsScheduledCaptureSet            bool                `queryID:"108" width:"1"`
+	MediaModeStatusBitmasked         uint                `queryID:"110" width:"1"`
+	HasStorageMinimumWriteSpeed      bool                `queryID:"111" width:"1"`
+	StorageWriteSpeedErrorsSinceBoot uint                `queryID:"112" width:"4"`
+	IsTurboTransferActive            bool                `queryID:"113" width:"1"`
+	CameraControlStatus              CameraControlStatus `queryID:"114" width:"1"`
+	IsConnectedViaUSB                bool                `queryID:"115" width:"1"`
+	UsbControlStaus                  UsbControlStatus    `queryID:"116" width:"1"`
+	TotalStorageSpace                datasize.ByteSize   `queryID:"117" width:"8" unit:"KB"`
 }
 
 // Convert a 64-bit byte slice to an unsigned int
@@ -111,12 +112,13 @@ func UnmarshalPartial(data []byte, r *Response) (int, error) {
 		return 0, errors.New("byte array is nil")
 	}
 
-	if len(data) < 3 {
-		return 0, fmt.Errorf("byte array length %d is less than minimum of 3: %v", len(data), data)
+	// Strings may be empty, so only the ID and count are required
+	if len(data) < 2 {
+		return 0, fmt.Errorf("byte array length %d is less than minimum of 2: %v", len(data), data)
 	}
 
 	// Status ID
-	id := int(data[0])
+	id := StatusID(data[0])
 
 	// Suggested count of values, according to byte array
 	suggestedValLength := int(data[1])
@@ -131,295 +133,11 @@ func UnmarshalPartial(data []byte, r *Response) (int, error) {
 
 	}
 
-	// Value of payload, as an unsigned int
-	valUint := bytesToUint(valBytes)
-	var updateBoolErr error = nil
-
-	updateBool := func(boolVar *bool) {
-
-		if valUint == 0 {
-			*boolVar = false
-
-		} else if valUint == 1 {
-			*boolVar = true
-
-		} else {
-			updateBoolErr = fmt.Errorf("number is not 0 or 1: \"%v\"", valUint)
-
-		}
-
-	}
-
-	updateTime := func(durationVar *time.Duration, durationType time.Duration) {
-		*durationVar = durationType * time.Duration(valUint)
-	}
-
-	updateSpace := func(spaceVar *datasize.ByteSize, spaceType datasize.ByteSize) {
-		*spaceVar = spaceType * datasize.ByteSize(valUint)
-	}
-
-	switch id {
-
-	case 1:
-		updateBool(&r.HasInternalBattery)
-
-	case 2:
-		r.BatteryLevelBars = valUint
-
-	case 3:
-		updateBool(&r.HasExternalBattery)
-
-	case 4:
-		r.ExternalBatteryPercent = valUint
-
-	case 6:
-		updateBool(&r.IsOverHeating)
-
-	case 8:
-		updateBool(&r.IsBusy)
-
-	case 9:
-		updateBool(&r.IsQuickCaptureEnabled)
-
-	case 10:
-		updateBool(&r.IsEncoding)
-
-	case 11:
-		updateBool(&r.IsLcdLockActive)
-
-	case 13:
-		r.VideoProgressCounter = valUint
-
-	case 17:
-		updateBool(&r.IsWirelessConnectionsEnabled)
-
-	case 19:
-		r.PairingStatus = valUint
-
-	case 20:
-		r.PairingType = valUint
-
-	case 21:
-		updateTime(&r.TimeSinceSuccessfulPairing, time.Millisecond)
-
-	case 22:
-		r.WifiScanStatus = valUint
-
-	case 23:
-		updateTime(&r.TimeSinceCompletedWifiScan, time.Millisecond)
-
-	case 24:
-		r.WifiProvisionStatus = valUint
-
-	case 26:
-		r.RemoteControlVersion = valUint
-
-	case 27:
-		updateBool(&r.IsRemoteControlConnected)
-
-	case 28:
-		r.WirelessPairingStatus = valUint
-
-	case 29:
-		r.WlanApSsid = string(valBytes[:])
-
-	case 30:
-		r.CameraApSsid = string(valBytes[:])
-
-	case 31:
-		r.WirelessDeviceCount = valUint
-
-	case 32:
-		updateBool(&r.IsPreviewStreamEnabled)
-
-	case 33:
-		_ = r.StorageStatus // TODO: Add case for converting the value bytes into a signed int for the camera storage status
-
-	case 34:
-		r.PhotosBeforeFull = valUint
-
-	case 35:
-		updateTime(&r.VideoTimeBeforeFull, time.Minute)
-
-	case 36:
-		r.GroupPhotosBeforeFull = valUint
-
-	case 37:
-		r.TotalGroupVideos = valUint
-
-	case 38:
-		r.TotalPhotos = valUint
-
-	case 39:
-		r.TotalVideos = valUint
-
-	case 41:
-		r.UpdateStatus = valUint
-
-	case 42:
-		updateBool(&r.IsCancellingUpdate)
-
-	case 45:
-		updateBool(&r.IsLocateCameraActive)
-
-	case 49:
-		r.MultishotCountdown = valUint
-
-	case 54:
-		updateSpace(&r.RemainingSpace, datasize.KB)
-
-	case 55:
-		updateBool(&r.IsPreviewStreamSupported)
-
-	case 56:
-		r.WifiBarStrentgh = valUint
-
-	case 58:
-		r.TagHilightsCount = valUint
-
-	case 59:
-		updateTime(&r.TimeSinceBootTagHilight, time.Millisecond)
-
-	case 60:
-		r.StatusUpdateMinIntervalMS = valUint
-
-	case 64:
-		updateTime(&r.TimelapseTimeBeforeFull, time.Minute)
-
-	case 65:
-		r.ExposureMode = valUint
-
-	case 66:
-		r.ExposureX = valUint
-
-	case 67:
-		r.ExposureY = valUint
-
-	case 68:
-		updateBool(&r.IsGpsLocked)
-
-	case 69:
-		updateBool(&r.IsWifiRadioEnabled)
-
-	case 70:
-		r.InternalBatteryPercent = valUint
-
-	case 74:
-		r.MicAccessoryStatus = valUint
-
-	case 75:
-		r.DigitalZoomPercent = valUint
-
-	case 76:
-		r.WifiBandMode = valUint
-
-	case 77:
-		updateBool(&r.IsDigitalZoomActive)
-
-	case 78:
-		updateBool(&r.IsVideoSettingsMobileFriendly)
-
-	case 79:
-		updateBool(&r.IsFirstTimeMode)
-
-	case 81:
-		updateBool(&r.IsWifi5GHzBandAvailable)
-
-	case 82:
-		updateBool(&r.IsReadyForCommands)
-
-	case 83:
-		updateBool(&r.IsBatteryGoodForUpdates)
-
-	case 85:
-		updateBool(&r.IsTooCold)
-
-	case 86:
-		r.Orientation = valUint
-
-	case 88:
-		updateBool(&r.IsZoomableWhileEncoding)
-
-	case 89:
-		r.FlatMode = valUint
-
-	case 93:
-		r.VideoPresetID = valUint
-
-	case 94:
-		r.PhotoPresetID = valUint
-
-	case 95:
-		r.TimelapsePresetID = valUint
-
-	case 96:
-		r.PresetGroupID = valUint
-
-	case 97:
-		r.PresetID = valUint
-
-	case 98:
-		r.PresetModified = valUint
-
-	case 99:
-		r.LiveBurstsBeforeFull = valUint
-
-	case 100:
-		r.LiveBursts = valUint
-
-	case 101:
-		updateBool(&r.IsCaptureDelayCountingDown)
-
-	case 102:
-		r.MediaModeStatus = valUint
-
-	case 103:
-		r.TimeWarpSpeed = valUint
-
-	case 104:
-		updateBool(&r.IsLinuxCoreActive)
-
-	case 105:
-		r.CameraLensType = valUint
-
-	case 106:
-		updateBool(&r.IsVideoHindsightCaptureActive)
-
-	case 107:
-		r.ScheduledCapturePresetID = valUint
-
-	case 108:
-		updateBool(&r.IsScheduledCaptureSet)
-
-	case 110:
-		r.MediaModeStatusBitmasked = valUint
-
-	case 111:
-		updateBool(&r.HasStorageMinimumWriteSpeed)
-
-	case 112:
-		r.StorageWriteSpeedErrorsSinceBoot = valUint
-
-	case 113:
-		updateBool(&r.IsTurboTransferActive)
-
-	case 114:
-		r.CameraControlStatus = valUint
-
-	case 115:
-		updateBool(&r.IsConnectedViaUSB)
-
-	case 116:
-		r.CameraControlStatus = valUint
-
-	case 117:
-		updateSpace(&r.TotalStorageSpace, datasize.KB)
-
-	default:
-		updateBoolErr = fmt.Errorf("status ID %d does not exist: \"%v\"", id, data)
-
+	field, ok := fieldsByID[id]
+	if !ok {
+		return suggestedValLength, fmt.Errorf("%w: %d: \"%v\"", ErrUnknownStatus, id, data)
 	}
 
-	return suggestedValLength, updateBoolErr
+	return suggestedValLength, field.decode(reflect.ValueOf(r).Elem().Field(field.index), valBytes)
 
 }
@@ -0,0 +1,96 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thatpix3l/persephone/pkg/settings"
+	"github.com/thatpix3l/persephone/pkg/tlv"
+)
+
+// ID of a single status
+type StatusID uint8
+
+// Returned when a status ID is not known to Response
+var ErrUnknownStatus = errors.New("status ID does not exist")
+
+// Outcome of unmarshaling a full query response, by status ID
+type Report struct {
+	QueryID byte // Query ID the response was for
+	tlv.Report[StatusID]
+}
+
+// Unmarshal an entire query response, as reassembled by packet.Accumulator, consisting of [query_ID, status, status_ID, count_of_values, val_1, val_2, ..., status_ID, ...], into the struct.
+//
+// Every status is decoded independently, so an unknown or malformed status does not prevent the rest from being decoded, and is instead recorded in the returned report.
+// Errors if the response is too short, is not for a status query, or the camera reported a failure.
+func Unmarshal(data []byte, r *Response) (Report, error) {
+
+	var report Report
+
+	if len(data) < 2 {
+		return report, fmt.Errorf("byte array length %d is less than minimum of 2: %v", len(data), data)
+	}
+
+	report.QueryID = data[0]
+	switch report.QueryID {
+	case IDGetStatusValues, IDRegisterStatusUpdates, IDStatusUpdate:
+	default:
+		return report, fmt.Errorf("query ID %#x is not a status query: %v", report.QueryID, data)
+	}
+
+	if status := data[1]; status != 0 {
+		return report, fmt.Errorf("query %#x failed with status %d", report.QueryID, status)
+	}
+
+	report.Report = tlv.Walk[StatusID](data[2:], ErrUnknownStatus, func(entry tlv.Entry) error {
+		_, err := UnmarshalPartial(entry.Bytes(), r)
+		return err
+	})
+
+	return report, nil
+
+}
+
+// Outcome of routing a query response with UnmarshalUpdate
+type Update struct {
+	QueryID  byte            // Query ID the response was for
+	Statuses Report          // Outcome of decoding statuses, if the response carried any
+	Settings settings.Report // Outcome of decoding settings, if the response carried any
+}
+
+// Unmarshal a query response, as reassembled by packet.Accumulator, that is either a reply to a (un)register request or an asynchronous update pushed by the camera.
+//
+// Status values are routed into "statuses" and setting values into "settingValues", so both can be kept up to date for as long as the camera pushes updates.
+// Replies to unregister requests carry no values, and only have their status checked.
+// Errors if the response is too short, is not for a (un)register query or update, or the camera reported a failure.
+func UnmarshalUpdate(data []byte, statuses *Response, settingValues *settings.Response) (Update, error) {
+
+	if len(data) < 2 {
+		return Update{}, fmt.Errorf("byte array length %d is less than minimum of 2: %v", len(data), data)
+	}
+
+	update := Update{QueryID: data[0]}
+	var err error = nil
+
+	switch update.QueryID {
+
+	case IDRegisterStatusUpdates, IDStatusUpdate:
+		update.Statuses, err = Unmarshal(data, statuses)
+
+	case IDRegisterSettingUpdates, IDSettingUpdate:
+		update.Settings, err = settings.Unmarshal(data, settingValues)
+
+	case IDUnregisterStatusUpdates, IDUnregisterSettingUpdates:
+		if status := data[1]; status != 0 {
+			err = fmt.Errorf("query %#x failed with status %d", update.QueryID, status)
+		}
+
+	default:
+		err = fmt.Errorf("query ID %#x is not a (un)register query or update: %v", update.QueryID, data)
+
+	}
+
+	return update, err
+
+}
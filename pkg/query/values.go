@@ -0,0 +1,364 @@
+package query
+
+import (
+	"fmt"
+)
+
+// Return the name of "v" from "names", or the type and number if it has none
+func enumString[E ~uint8 | ~int8](typeName string, names map[E]string, v E) string {
+	if name, ok := names[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", typeName, int64(v))
+}
+
+// State of the primary storage, signed as the camera reports -1 when it cannot tell
+type StorageStatus int8
+
+const (
+	StorageStatusUnknown           StorageStatus = -1
+	StorageStatusOK                StorageStatus = 0
+	StorageStatusSDCardFull        StorageStatus = 1
+	StorageStatusSDCardMissing     StorageStatus = 2
+	StorageStatusSDCardFormatError StorageStatus = 3
+	StorageStatusSDCardBusy        StorageStatus = 4
+	StorageStatusSDCardSwapped     StorageStatus = 8
+)
+
+var storageStatusNames = map[StorageStatus]string{
+	StorageStatusUnknown:           "Unknown",
+	StorageStatusOK:                "OK",
+	StorageStatusSDCardFull:        "SD Card Full",
+	StorageStatusSDCardMissing:     "SD Card Missing",
+	StorageStatusSDCardFormatError: "SD Card Format Error",
+	StorageStatusSDCardBusy:        "SD Card Busy",
+	StorageStatusSDCardSwapped:     "SD Card Swapped",
+}
+
+func (s StorageStatus) String() string {
+	return enumString("StorageStatus", storageStatusNames, s)
+}
+
+type PairingStatus uint8
+
+const (
+	PairingStatusNeverStarted PairingStatus = 0
+	PairingStatusStarted      PairingStatus = 1
+	PairingStatusAborted      PairingStatus = 2
+	PairingStatusCancelled    PairingStatus = 3
+	PairingStatusCompleted    PairingStatus = 4
+)
+
+var pairingStatusNames = map[PairingStatus]string{
+	PairingStatusNeverStarted: "Never Started",
+	PairingStatusStarted:      "Started",
+	PairingStatusAborted:      "Aborted",
+	PairingStatusCancelled:    "Cancelled",
+	PairingStatusCompleted:    "Completed",
+}
+
+func (p PairingStatus) String() string {
+	return enumString("PairingStatus", pairingStatusNames, p)
+}
+
+type PairingType uint8
+
+const (
+	PairingTypeNotPairing      PairingType = 0
+	PairingTypeApp             PairingType = 1
+	PairingTypeRemoteControl   PairingType = 2
+	PairingTypeBluetoothDevice PairingType = 3
+)
+
+var pairingTypeNames = map[PairingType]string{
+	PairingTypeNotPairing:      "Not Pairing",
+	PairingTypeApp:             "App",
+	PairingTypeRemoteControl:   "Remote Control",
+	PairingTypeBluetoothDevice: "Bluetooth Device",
+}
+
+func (p PairingType) String() string {
+	return enumString("PairingType", pairingTypeNames, p)
+}
+
+type WifiScanStatus uint8
+
+const (
+	WifiScanStatusNeverStarted WifiScanStatus = 0
+	WifiScanStatusStarted      WifiScanStatus = 1
+	WifiScanStatusAborted      WifiScanStatus = 2
+	WifiScanStatusCancelled    WifiScanStatus = 3
+	WifiScanStatusCompleted    WifiScanStatus = 4
+)
+
+var wifiScanStatusNames = map[WifiScanStatus]string{
+	WifiScanStatusNeverStarted: "Never Started",
+	WifiScanStatusStarted:      "Started",
+	WifiScanStatusAborted:      "Aborted",
+	WifiScanStatusCancelled:    "Cancelled",
+	WifiScanStatusCompleted:    "Completed",
+}
+
+func (w WifiScanStatus) String() string {
+	return enumString("WifiScanStatus", wifiScanStatusNames, w)
+}
+
+type WifiProvisionStatus uint8
+
+const (
+	WifiProvisionStatusNeverStarted WifiProvisionStatus = 0
+	WifiProvisionStatusStarted      WifiProvisionStatus = 1
+	WifiProvisionStatusAborted      WifiProvisionStatus = 2
+	WifiProvisionStatusCancelled    WifiProvisionStatus = 3
+	WifiProvisionStatusCompleted    WifiProvisionStatus = 4
+)
+
+var wifiProvisionStatusNames = map[WifiProvisionStatus]string{
+	WifiProvisionStatusNeverStarted: "Never Started",
+	WifiProvisionStatusStarted:      "Started",
+	WifiProvisionStatusAborted:      "Aborted",
+	WifiProvisionStatusCancelled:    "Cancelled",
+	WifiProvisionStatusCompleted:    "Completed",
+}
+
+func (w WifiProvisionStatus) String() string {
+	return enumString("WifiProvisionStatus", wifiProvisionStatusNames, w)
+}
+
+type ExposureMode uint8
+
+const (
+	ExposureModeDisabled   ExposureMode = 0
+	ExposureModeAuto       ExposureMode = 1
+	ExposureModeISOLock    ExposureMode = 2
+	ExposureModeHemisphere ExposureMode = 3
+)
+
+var exposureModeNames = map[ExposureMode]string{
+	ExposureModeDisabled:   "Disabled",
+	ExposureModeAuto:       "Auto",
+	ExposureModeISOLock:    "ISO Lock",
+	ExposureModeHemisphere: "Hemisphere",
+}
+
+func (e ExposureMode) String() string {
+	return enumString("ExposureMode", exposureModeNames, e)
+}
+
+type Orientation uint8
+
+const (
+	OrientationUpright     Orientation = 0 // 0 degrees
+	OrientationUpsideDown  Orientation = 1 // 180 degrees
+	OrientationOnRightSide Orientation = 2 // 90 degrees
+	OrientationOnLeftSide  Orientation = 3 // 270 degrees
+)
+
+var orientationNames = map[Orientation]string{
+	OrientationUpright:     "Upright",
+	OrientationUpsideDown:  "Upside Down",
+	OrientationOnRightSide: "Landscape Right",
+	OrientationOnLeftSide:  "Landscape Left",
+}
+
+func (o Orientation) String() string {
+	return enumString("Orientation", orientationNames, o)
+}
+
+type MicAccessoryStatus uint8
+
+const (
+	MicAccessoryStatusNotConnected MicAccessoryStatus = 0
+	MicAccessoryStatusConnected    MicAccessoryStatus = 1
+	MicAccessoryStatusExternalMic  MicAccessoryStatus = 2 // Accessory connected, with a microphone plugged into it
+)
+
+var micAccessoryStatusNames = map[MicAccessoryStatus]string{
+	MicAccessoryStatusNotConnected: "Not Connected",
+	MicAccessoryStatusConnected:    "Connected",
+	MicAccessoryStatusExternalMic:  "External Mic",
+}
+
+func (m MicAccessoryStatus) String() string {
+	return enumString("MicAccessoryStatus", micAccessoryStatusNames, m)
+}
+
+type WifiBandMode uint8
+
+const (
+	WifiBandMode2Point4GHz WifiBandMode = 0
+	WifiBandMode5GHz       WifiBandMode = 1
+	WifiBandModeMax        WifiBandMode = 2
+)
+
+var wifiBandModeNames = map[WifiBandMode]string{
+	WifiBandMode2Point4GHz: "2.4 GHz",
+	WifiBandMode5GHz:       "5 GHz",
+	WifiBandModeMax:        "Max",
+}
+
+func (w WifiBandMode) String() string {
+	return enumString("WifiBandMode", wifiBandModeNames, w)
+}
+
+type FlatMode uint8
+
+const (
+	FlatModePlayback           FlatMode = 4
+	FlatModeSetup              FlatMode = 5
+	FlatModeVideo              FlatMode = 12
+	FlatModeTimeLapseVideo     FlatMode = 13
+	FlatModeLooping            FlatMode = 15
+	FlatModePhotoSingle        FlatMode = 16
+	FlatModePhoto              FlatMode = 17
+	FlatModePhotoNight         FlatMode = 18
+	FlatModePhotoBurst         FlatMode = 19
+	FlatModeTimeLapsePhoto     FlatMode = 20
+	FlatModeNightLapsePhoto    FlatMode = 21
+	FlatModeBroadcastRecord    FlatMode = 22
+	FlatModeBroadcastBroadcast FlatMode = 23
+	FlatModeTimeWarpVideo      FlatMode = 24
+	FlatModePhotoLiveBurst     FlatMode = 25
+	FlatModeNightLapseVideo    FlatMode = 26
+	FlatModeSloMo              FlatMode = 27
+	FlatModeIdle               FlatMode = 28
+	FlatModeVideoStarTrail     FlatMode = 29
+	FlatModeVideoLightPainting FlatMode = 30
+	FlatModeVideoLightTrail    FlatMode = 31
+	FlatModeVideoBurstSloMo    FlatMode = 32
+)
+
+var flatModeNames = map[FlatMode]string{
+	FlatModePlayback:           "Playback",
+	FlatModeSetup:              "Setup",
+	FlatModeVideo:              "Video",
+	FlatModeTimeLapseVideo:     "Time Lapse Video",
+	FlatModeLooping:            "Looping",
+	FlatModePhotoSingle:        "Single Photo",
+	FlatModePhoto:              "Photo",
+	FlatModePhotoNight:         "Night Photo",
+	FlatModePhotoBurst:         "Burst Photo",
+	FlatModeTimeLapsePhoto:     "Time Lapse Photo",
+	FlatModeNightLapsePhoto:    "Night Lapse Photo",
+	FlatModeBroadcastRecord:    "Broadcast Record",
+	FlatModeBroadcastBroadcast: "Broadcast",
+	FlatModeTimeWarpVideo:      "TimeWarp Video",
+	FlatModePhotoLiveBurst:     "Live Burst",
+	FlatModeNightLapseVideo:    "Night Lapse Video",
+	FlatModeSloMo:              "Slo-Mo",
+	FlatModeIdle:               "Idle",
+	FlatModeVideoStarTrail:     "Star Trails",
+	FlatModeVideoLightPainting: "Light Painting",
+	FlatModeVideoLightTrail:    "Vehicle Lights",
+	FlatModeVideoBurstSloMo:    "Burst Slo-Mo",
+}
+
+func (f FlatMode) String() string {
+	return enumString("FlatMode", flatModeNames, f)
+}
+
+type CameraControlStatus uint8
+
+const (
+	CameraControlStatusIdle            CameraControlStatus = 0
+	CameraControlStatusControl         CameraControlStatus = 1 // Camera is being controlled through its own UI
+	CameraControlStatusExternalControl CameraControlStatus = 2 // Camera is being controlled by an external app
+)
+
+var cameraControlStatusNames = map[CameraControlStatus]string{
+	CameraControlStatusIdle:            "Idle",
+	CameraControlStatusControl:         "Camera Control",
+	CameraControlStatusExternalControl: "External Control",
+}
+
+func (c CameraControlStatus) String() string {
+	return enumString("CameraControlStatus", cameraControlStatusNames, c)
+}
+
+type UsbControlStatus uint8
+
+const (
+	UsbControlStatusDisabled UsbControlStatus = 0
+	UsbControlStatusEnabled  UsbControlStatus = 1
+)
+
+var usbControlStatusNames = map[UsbControlStatus]string{
+	UsbControlStatusDisabled: "Disabled",
+	UsbControlStatusEnabled:  "Enabled",
+}
+
+func (u UsbControlStatus) String() string {
+	return enumString("UsbControlStatus", usbControlStatusNames, u)
+}
+
+type MediaModeStatus uint8
+
+const (
+	MediaModeStatusRemoved         MediaModeStatus = 0
+	MediaModeStatusOnlyMediaMod    MediaModeStatus = 2
+	MediaModeStatusWithExternalMic MediaModeStatus = 3
+)
+
+var mediaModeStatusNames = map[MediaModeStatus]string{
+	MediaModeStatusRemoved:         "Media Mod Removed",
+	MediaModeStatusOnlyMediaMod:    "Media Mod",
+	MediaModeStatusWithExternalMic: "Media Mod With External Mic",
+}
+
+func (m MediaModeStatus) String() string {
+	return enumString("MediaModeStatus", mediaModeStatusNames, m)
+}
+
+type TimeWarpSpeed uint8
+
+const (
+	TimeWarpSpeed15x   TimeWarpSpeed = 0
+	TimeWarpSpeed30x   TimeWarpSpeed = 1
+	TimeWarpSpeed60x   TimeWarpSpeed = 2
+	TimeWarpSpeed150x  TimeWarpSpeed = 3
+	TimeWarpSpeed300x  TimeWarpSpeed = 4
+	TimeWarpSpeed900x  TimeWarpSpeed = 5
+	TimeWarpSpeed1800x TimeWarpSpeed = 6
+	TimeWarpSpeed2x    TimeWarpSpeed = 7
+	TimeWarpSpeed5x    TimeWarpSpeed = 8
+	TimeWarpSpeed10x   TimeWarpSpeed = 9
+	TimeWarpSpeedAuto  TimeWarpSpeed = 10
+	TimeWarpSpeed1x    TimeWarpSpeed = 11 // Real time
+	TimeWarpSpeedHalfx TimeWarpSpeed = 12 // Slow motion
+)
+
+var timeWarpSpeedNames = map[TimeWarpSpeed]string{
+	TimeWarpSpeed15x:   "15x",
+	TimeWarpSpeed30x:   "30x",
+	TimeWarpSpeed60x:   "60x",
+	TimeWarpSpeed150x:  "150x",
+	TimeWarpSpeed300x:  "300x",
+	TimeWarpSpeed900x:  "900x",
+	TimeWarpSpeed1800x: "1800x",
+	TimeWarpSpeed2x:    "2x",
+	TimeWarpSpeed5x:    "5x",
+	TimeWarpSpeed10x:   "10x",
+	TimeWarpSpeedAuto:  "Auto",
+	TimeWarpSpeed1x:    "1x",
+	TimeWarpSpeedHalfx: "1/2x",
+}
+
+func (t TimeWarpSpeed) String() string {
+	return enumString("TimeWarpSpeed", timeWarpSpeedNames, t)
+}
+
+type CameraLensType uint8
+
+const (
+	CameraLensTypeDefault CameraLensType = 0
+	CameraLensTypeMaxLens CameraLensType = 1
+)
+
+var cameraLensTypeNames = map[CameraLensType]string{
+	CameraLensTypeDefault: "Default",
+	CameraLensTypeMaxLens: "Max Lens",
+}
+
+func (c CameraLensType) String() string {
+	return enumString("CameraLensType", cameraLensTypeNames, c)
+}
@@ -1 +1,162 @@
+// Utilities for building byte sequences that change the camera's settings, as well as unmarshaling the camera's replies
 package settings
+
+import (
+	"github.com/thatpix3l/persephone/pkg/packet"
+)
+
+const (
+	Action actionT = iota // Root of all functions for generating setting byte sequences
+)
+
+type actionT int
+
+func boolToUint8(b bool) uint8 {
+	if b {
+		return 1
+	} else {
+		return 0
+	}
+}
+
+// Build the message setting "id" to "value", framed into packets ready to be written to the settings characteristic
+func buildAction(id ID, value byte) ([][]byte, error) {
+	return packet.Frame([]byte{byte(id), 1, value})
+}
+
+// Set any setting to a raw value, for settings without a dedicated builder
+func (a actionT) Set(id ID, value byte) ([][]byte, error) {
+	return buildAction(id, value)
+}
+
+func (a actionT) SetVideoResolution(r VideoResolution) ([][]byte, error) {
+	return buildAction(IDVideoResolution, byte(r))
+}
+
+func (a actionT) SetFPS(f FPS) ([][]byte, error) {
+	return buildAction(IDFPS, byte(f))
+}
+
+func (a actionT) SetWebcamFOV(f WebcamFOV) ([][]byte, error) {
+	return buildAction(IDWebcamFOV, byte(f))
+}
+
+func (a actionT) SetAutoPowerDown(d AutoPowerDown) ([][]byte, error) {
+	return buildAction(IDAutoPowerDown, byte(d))
+}
+
+func (a actionT) SetVideoFOV(f VideoFOV) ([][]byte, error) {
+	return buildAction(IDVideoFOV, byte(f))
+}
+
+func (a actionT) SetPhotoFOV(f PhotoFOV) ([][]byte, error) {
+	return buildAction(IDPhotoFOV, byte(f))
+}
+
+func (a actionT) SetTimeLapseFOV(f PhotoFOV) ([][]byte, error) {
+	return buildAction(IDTimeLapseFOV, byte(f))
+}
+
+func (a actionT) SetMediaFormat(f MediaFormat) ([][]byte, error) {
+	return buildAction(IDMediaFormat, byte(f))
+}
+
+func (a actionT) SetAntiFlicker(f AntiFlicker) ([][]byte, error) {
+	return buildAction(IDAntiFlicker, byte(f))
+}
+
+func (a actionT) SetHypersmooth(h Hypersmooth) ([][]byte, error) {
+	return buildAction(IDHypersmooth, byte(h))
+}
+
+func (a actionT) SetVideoHorizonLeveling(l HorizonLeveling) ([][]byte, error) {
+	return buildAction(IDVideoHorizonLeveling, byte(l))
+}
+
+func (a actionT) SetPhotoHorizonLeveling(l HorizonLeveling) ([][]byte, error) {
+	return buildAction(IDPhotoHorizonLeveling, byte(l))
+}
+
+func (a actionT) SetMaxLens(on bool) ([][]byte, error) {
+	return buildAction(IDMaxLens, boolToUint8(on))
+}
+
+func (a actionT) SetHindSight(h HindSight) ([][]byte, error) {
+	return buildAction(IDHindSight, byte(h))
+}
+
+func (a actionT) SetPhotoSingleInterval(i PhotoSingleInterval) ([][]byte, error) {
+	return buildAction(IDPhotoSingleInterval, byte(i))
+}
+
+func (a actionT) SetPhotoIntervalDuration(d PhotoIntervalDuration) ([][]byte, error) {
+	return buildAction(IDPhotoIntervalDuration, byte(d))
+}
+
+func (a actionT) SetVideoPerformanceMode(m VideoPerformanceMode) ([][]byte, error) {
+	return buildAction(IDVideoPerformanceMode, byte(m))
+}
+
+func (a actionT) SetControls(c Controls) ([][]byte, error) {
+	return buildAction(IDControls, byte(c))
+}
+
+func (a actionT) SetEasyModeSpeed(s EasyModeSpeed) ([][]byte, error) {
+	return buildAction(IDEasyModeSpeed, byte(s))
+}
+
+func (a actionT) SetNightPhoto(on bool) ([][]byte, error) {
+	return buildAction(IDNightPhoto, boolToUint8(on))
+}
+
+func (a actionT) SetWirelessBand(b WirelessBand) ([][]byte, error) {
+	return buildAction(IDWirelessBand, byte(b))
+}
+
+func (a actionT) SetTrailLength(l TrailLength) ([][]byte, error) {
+	return buildAction(IDTrailLength, byte(l))
+}
+
+func (a actionT) SetVideoMode(m VideoMode) ([][]byte, error) {
+	return buildAction(IDVideoMode, byte(m))
+}
+
+func (a actionT) SetBitRate(r BitRate) ([][]byte, error) {
+	return buildAction(IDBitRate, byte(r))
+}
+
+func (a actionT) SetBitDepth(d BitDepth) ([][]byte, error) {
+	return buildAction(IDBitDepth, byte(d))
+}
+
+func (a actionT) SetProfile(p Profile) ([][]byte, error) {
+	return buildAction(IDProfile, byte(p))
+}
+
+func (a actionT) SetVideoQuality(q VideoQuality) ([][]byte, error) {
+	return buildAction(IDVideoQuality, byte(q))
+}
+
+func (a actionT) SetLapseMode(m LapseMode) ([][]byte, error) {
+	return buildAction(IDLapseMode, byte(m))
+}
+
+func (a actionT) SetMaxLensMod(m MaxLensMod) ([][]byte, error) {
+	return buildAction(IDMaxLensMod, byte(m))
+}
+
+func (a actionT) SetMaxLensModEnabled(on bool) ([][]byte, error) {
+	return buildAction(IDMaxLensModEnabled, boolToUint8(on))
+}
+
+func (a actionT) SetPhotoMode(m PhotoMode) ([][]byte, error) {
+	return buildAction(IDPhotoMode, byte(m))
+}
+
+func (a actionT) SetAspectRatio(r AspectRatio) ([][]byte, error) {
+	return buildAction(IDAspectRatio, byte(r))
+}
+
+func (a actionT) SetFraming(f Framing) ([][]byte, error) {
+	return buildAction(IDFraming, byte(f))
+}
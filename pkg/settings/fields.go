@@ -0,0 +1,107 @@
+package settings
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Describes how a single setting maps onto a field of Response, as declared by the field's tags
+type Field struct {
+	ID   ID     // From the "settingID" tag
+	Name string // Name of the field in Response
+
+	index int          // Index of the field in Response
+	kind  reflect.Kind // Kind of the field
+}
+
+// Every setting in Response, indexed by ID and in ascending order of ID
+var (
+	fieldsByID = map[ID]Field{}
+	fieldList  = []Field{}
+)
+
+func init() {
+
+	t := reflect.TypeOf(Response{})
+
+	for i := 0; i < t.NumField(); i++ {
+
+		structField := t.Field(i)
+		field, err := newField(i, structField)
+		if err != nil {
+			// Tags are fixed at compile time, so a bad one is a bug in Response itself
+			panic(fmt.Sprintf("settings.Response.%s: %v", structField.Name, err))
+		}
+
+		if _, ok := fieldsByID[field.ID]; ok {
+			panic(fmt.Sprintf("settings.Response.%s: setting ID %d is declared more than once", structField.Name, field.ID))
+		}
+
+		fieldsByID[field.ID] = field
+		fieldList = append(fieldList, field)
+
+	}
+
+	sort.Slice(fieldList, func(i, j int) bool { return fieldList[i].ID < fieldList[j].ID })
+
+}
+
+// Build and validate the description of a single field of Response from its tags
+func newField(index int, structField reflect.StructField) (Field, error) {
+
+	tag, ok := structField.Tag.Lookup("settingID")
+	if !ok {
+		return Field{}, fmt.Errorf("missing settingID tag")
+	}
+
+	id, err := strconv.ParseUint(tag, 10, 8)
+	if err != nil {
+		return Field{}, fmt.Errorf("settingID tag %q is not a byte: %w", tag, err)
+	}
+
+	field := Field{
+		ID:    ID(id),
+		Name:  structField.Name,
+		index: index,
+		kind:  structField.Type.Kind(),
+	}
+
+	// Every setting value is a single byte, either an option of the setting or a boolean
+	switch field.kind {
+	case reflect.Bool, reflect.Uint8:
+	default:
+		return Field{}, fmt.Errorf("kind %v is not supported", field.kind)
+	}
+
+	return field, nil
+
+}
+
+// Return every setting in Response, in ascending order of ID
+func Fields() []Field {
+	return append([]Field{}, fieldList...)
+}
+
+// Return the setting with the given ID, and whether it exists in Response
+func FieldByID(id ID) (Field, bool) {
+	field, ok := fieldsByID[id]
+	return field, ok
+}
+
+// Decode "val" into "v", the field of Response described by "f"
+func (f Field) decode(v reflect.Value, val uint8) error {
+
+	if f.kind == reflect.Bool {
+		if val > 1 {
+			return fmt.Errorf("number is not 0 or 1: \"%v\"", val)
+		}
+		v.SetBool(val == 1)
+		return nil
+	}
+
+	v.SetUint(uint64(val))
+	return nil
+
+}
@@ -0,0 +1,180 @@
+package settings
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/thatpix3l/persephone/pkg/tlv"
+	"github.com/thatpix3l/persephone/pkg/zeropad"
+)
+
+// Query IDs whose responses carry setting values
+const (
+	QueryIDGetSettingValues       byte = 0x12 // Get setting values
+	QueryIDRegisterSettingUpdates byte = 0x52 // Register for setting value updates
+	QueryIDSettingUpdate          byte = 0x92 // Asynchronous setting value update
+)
+
+// Returned when a setting ID is not known to Response
+var ErrUnknownSetting = errors.New("setting ID does not exist")
+
+type Response struct {
+	VideoResolution       VideoResolution       `settingID:"2"`
+	FPS                   FPS                   `settingID:"3"`
+	WebcamFOV             WebcamFOV             `settingID:"43"`
+	AutoPowerDown         AutoPowerDown         `settingID:"59"`
+	VideoFOV              VideoFOV              `settingID:"121"`
+	PhotoFOV              PhotoFOV              `settingID:"122"`
+	TimeLapseFOV          PhotoFOV              `settingID:"123"`
+	MediaFormat           MediaFormat           `settingID:"128"`
+	AntiFlicker           AntiFlicker           `settingID:"134"`
+	Hypersmooth           Hypersmooth           `settingID:"135"`
+	VideoHorizonLeveling  HorizonLeveling       `settingID:"150"`
+	PhotoHorizonLeveling  HorizonLeveling       `settingID:"151"`
+	MaxLens               bool                  `settingID:"162"`
+	HindSight             HindSight             `settingID:"167"`
+	PhotoSingleInterval   PhotoSingleInterval   `settingID:"171"`
+	PhotoIntervalDuration PhotoIntervalDuration `settingID:"172"`
+	VideoPerformanceMode  VideoPerformanceMode  `settingID:"173"`
+	Controls              Controls              `settingID:"175"`
+	EasyModeSpeed         EasyModeSpeed         `settingID:"176"`
+	NightPhoto            bool                  `settingID:"177"`
+	WirelessBand          WirelessBand          `settingID:"178"`
+	TrailLength           TrailLength           `settingID:"179"`
+	VideoMode             VideoMode             `settingID:"180"`
+	BitRate               BitRate               `settingID:"182"`
+	BitDepth              BitDepth              `settingID:"183"`
+	Profile               Profile               `settingID:"184"`
+	VideoQuality          VideoQuality          `settingID:"186"`
+	LapseMode             LapseMode             `settingID:"187"`
+	MaxLensMod            MaxLensMod            `settingID:"189"`
+	MaxLensModEnabled     bool                  `settingID:"190"`
+	PhotoMode             PhotoMode             `settingID:"191"`
+	AspectRatio           AspectRatio           `settingID:"192"`
+	Framing               Framing               `settingID:"193"`
+}
+
+// Unmarshal from "data", a Big-Endian encoded byte array consisting of the [setting_ID, count_of_values, val_1, val2, ...] extracted from a full GoPro Query Response, into the struct.
+func UnmarshalPartial(data []byte, r *Response) (int, error) {
+
+	if data == nil {
+		return 0, errors.New("byte array is nil")
+	}
+
+	if len(data) < 3 {
+		return 0, fmt.Errorf("byte array length %d is less than minimum of 3: %v", len(data), data)
+	}
+
+	// Setting ID
+	id := ID(data[0])
+
+	// Suggested count of values, according to byte array
+	suggestedValLength := int(data[1])
+
+	// Slice of actual values
+	valBytes := data[2:]
+
+	// Actual count of values
+	actualValLength := len(valBytes)
+	if suggestedValLength != actualValLength {
+		return 0, fmt.Errorf("byte array suggests value count of %d, does not match actual count %d: %v", suggestedValLength, actualValLength, data)
+	}
+
+	field, ok := fieldsByID[id]
+	if !ok {
+		return suggestedValLength, fmt.Errorf("%w: %d: \"%v\"", ErrUnknownSetting, id, data)
+	}
+
+	if actualValLength > 8 {
+		return 0, fmt.Errorf("value count of %d is more than maximum of 8: %v", actualValLength, data)
+	}
+
+	// Every setting value is a single byte, though the camera is free to send it zero padded
+	val64 := binary.BigEndian.Uint64(zeropad.BigEndian64(valBytes))
+	if val64 > 0xff {
+		return 0, fmt.Errorf("value %d does not fit in a byte: %v", val64, data)
+	}
+	val := uint8(val64)
+
+	return suggestedValLength, field.decode(reflect.ValueOf(r).Elem().Field(field.index), val)
+
+}
+
+// Outcome of unmarshaling a full query response, by setting ID
+type Report struct {
+	QueryID byte // Query ID the response was for
+	tlv.Report[ID]
+}
+
+// Unmarshal an entire query response, as reassembled by packet.Accumulator, consisting of [query_ID, status, setting_ID, count_of_values, val_1, val_2, ..., setting_ID, ...], into the struct.
+//
+// Every setting is decoded independently, so an unknown or malformed setting does not prevent the rest from being decoded, and is instead recorded in the returned report.
+// Errors if the response is too short, is not for a setting query, or the camera reported a failure.
+func Unmarshal(data []byte, r *Response) (Report, error) {
+
+	var report Report
+
+	if len(data) < 2 {
+		return report, fmt.Errorf("byte array length %d is less than minimum of 2: %v", len(data), data)
+	}
+
+	report.QueryID = data[0]
+	switch report.QueryID {
+	case QueryIDGetSettingValues, QueryIDRegisterSettingUpdates, QueryIDSettingUpdate:
+	default:
+		return report, fmt.Errorf("query ID %#x is not a setting query: %v", report.QueryID, data)
+	}
+
+	if status := Result(data[1]); status != ResultSuccess {
+		return report, fmt.Errorf("query %#x failed: %v", report.QueryID, status)
+	}
+
+	report.Report = tlv.Walk[ID](data[2:], ErrUnknownSetting, func(entry tlv.Entry) error {
+		_, err := UnmarshalPartial(entry.Bytes(), r)
+		return err
+	})
+
+	return report, nil
+
+}
+
+// Result code the camera replies with after being asked to change a setting
+type Result uint8
+
+const (
+	ResultSuccess          Result = 0
+	ResultError            Result = 1
+	ResultInvalidParameter Result = 2
+)
+
+func (r Result) String() string {
+	switch r {
+	case ResultSuccess:
+		return "Success"
+	case ResultError:
+		return "Error"
+	case ResultInvalidParameter:
+		return "Invalid Parameter"
+	}
+	return fmt.Sprintf("Result(%d)", uint8(r))
+}
+
+// Unmarshal the camera's reply on the settings response characteristic, as reassembled by packet.Accumulator, consisting of [setting_ID, result].
+//
+// Errors if the reply is malformed, or the result is anything but success.
+func UnmarshalResult(data []byte) (ID, error) {
+
+	if len(data) != 2 {
+		return 0, fmt.Errorf("byte array length %d is not 2: %v", len(data), data)
+	}
+
+	id := ID(data[0])
+	if result := Result(data[1]); result != ResultSuccess {
+		return id, fmt.Errorf("setting %d was not changed: %v", id, result)
+	}
+
+	return id, nil
+
+}
@@ -0,0 +1,57 @@
+package settings
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalReport(t *testing.T) {
+
+	// Known, zero padded, bool, unknown, bool out of range, then too wide for a byte
+	data := []byte{QueryIDGetSettingValues, 0, 2, 1, 1, 3, 2, 0, 8, 162, 1, 1, 250, 1, 0, 177, 1, 2, 121, 2, 1, 0}
+
+	var r Response
+	report, err := Unmarshal(data, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(report.Present, []ID{IDVideoResolution, IDFPS, IDMaxLens}) {
+		t.Errorf("present %v, want [2 3 162]", report.Present)
+	}
+	if r.VideoResolution != Res4K || r.FPS != FPS30 || !r.MaxLens {
+		t.Errorf("got %+v, want 4K at 30 FPS with max lens", r)
+	}
+	if !reflect.DeepEqual(report.Unknown, []ID{250}) {
+		t.Errorf("unknown %v, want [250]", report.Unknown)
+	}
+	for _, id := range []ID{IDNightPhoto, IDVideoFOV} {
+		if _, ok := report.Malformed[id]; !ok {
+			t.Errorf("setting %d is not malformed", id)
+		}
+	}
+
+}
+
+func TestFields(t *testing.T) {
+
+	// Tags must agree with the IDs the rest of the package uses
+	tests := map[ID]string{
+		IDVideoResolution: "VideoResolution",
+		IDFPS:             "FPS",
+		IDMaxLens:         "MaxLens",
+		IDTimeLapseFOV:    "TimeLapseFOV",
+		IDFraming:         "Framing",
+	}
+
+	for id, name := range tests {
+		if field, ok := FieldByID(id); !ok || field.Name != name {
+			t.Errorf("setting %d is %q, want %q", id, field.Name, name)
+		}
+	}
+
+	if len(Fields()) != reflect.TypeOf(Response{}).NumField() {
+		t.Errorf("got %d fields, want one per field of Response", len(Fields()))
+	}
+
+}
@@ -0,0 +1,311 @@
+package settings
+
+// ID of a single setting
+type ID uint8
+
+const (
+	IDVideoResolution       ID = 2
+	IDFPS                   ID = 3
+	IDWebcamFOV             ID = 43
+	IDAutoPowerDown         ID = 59
+	IDVideoFOV              ID = 121
+	IDPhotoFOV              ID = 122
+	IDTimeLapseFOV          ID = 123
+	IDMediaFormat           ID = 128
+	IDAntiFlicker           ID = 134
+	IDHypersmooth           ID = 135
+	IDVideoHorizonLeveling  ID = 150
+	IDPhotoHorizonLeveling  ID = 151
+	IDMaxLens               ID = 162
+	IDHindSight             ID = 167
+	IDPhotoSingleInterval   ID = 171
+	IDPhotoIntervalDuration ID = 172
+	IDVideoPerformanceMode  ID = 173
+	IDControls              ID = 175
+	IDEasyModeSpeed         ID = 176
+	IDNightPhoto            ID = 177
+	IDWirelessBand          ID = 178
+	IDTrailLength           ID = 179
+	IDVideoMode             ID = 180
+	IDBitRate               ID = 182
+	IDBitDepth              ID = 183
+	IDProfile               ID = 184
+	IDVideoQuality          ID = 186
+	IDLapseMode             ID = 187
+	IDMaxLensMod            ID = 189
+	IDMaxLensModEnabled     ID = 190
+	IDPhotoMode             ID = 191
+	IDAspectRatio           ID = 192
+	IDFraming               ID = 193
+)
+
+type VideoResolution uint8
+
+const (
+	Res4K          VideoResolution = 1
+	Res2Point7K    VideoResolution = 4
+	Res2Point7K4x3 VideoResolution = 6
+	Res1440        VideoResolution = 7
+	Res1080        VideoResolution = 9
+	Res4K4x3       VideoResolution = 18
+	Res5K          VideoResolution = 24
+	Res5K4x3       VideoResolution = 25
+	Res5Point3K8x7 VideoResolution = 26
+	Res5Point3K4x3 VideoResolution = 27
+	Res4K8x7       VideoResolution = 28
+	Res5Point3K    VideoResolution = 100
+)
+
+type FPS uint8
+
+const (
+	FPS240 FPS = 0
+	FPS120 FPS = 1
+	FPS100 FPS = 2
+	FPS60  FPS = 5
+	FPS50  FPS = 6
+	FPS30  FPS = 8
+	FPS25  FPS = 9
+	FPS24  FPS = 10
+	FPS200 FPS = 13
+)
+
+type WebcamFOV uint8
+
+const (
+	WebcamFOVWide      WebcamFOV = 0
+	WebcamFOVNarrow    WebcamFOV = 2
+	WebcamFOVSuperView WebcamFOV = 3
+	WebcamFOVLinear    WebcamFOV = 4
+)
+
+type AutoPowerDown uint8
+
+const (
+	AutoPowerDownNever     AutoPowerDown = 0
+	AutoPowerDown1Minute   AutoPowerDown = 1
+	AutoPowerDown5Minutes  AutoPowerDown = 4
+	AutoPowerDown15Minutes AutoPowerDown = 6
+	AutoPowerDown30Minutes AutoPowerDown = 7
+	AutoPowerDown8Seconds  AutoPowerDown = 11
+	AutoPowerDown30Seconds AutoPowerDown = 12
+)
+
+type VideoFOV uint8
+
+const (
+	VideoFOVWide                  VideoFOV = 0
+	VideoFOVNarrow                VideoFOV = 2
+	VideoFOVSuperView             VideoFOV = 3
+	VideoFOVLinear                VideoFOV = 4
+	VideoFOVMaxSuperView          VideoFOV = 7
+	VideoFOVLinearHorizonLeveling VideoFOV = 8
+	VideoFOVHyperView             VideoFOV = 9
+	VideoFOVLinearHorizonLock     VideoFOV = 10
+	VideoFOVMaxHyperView          VideoFOV = 11
+)
+
+// Field of view shared by photo and time lapse presets
+type PhotoFOV uint8
+
+const (
+	PhotoFOVNarrow       PhotoFOV = 19
+	PhotoFOVMaxSuperView PhotoFOV = 100
+	PhotoFOVWide         PhotoFOV = 101
+	PhotoFOVLinear       PhotoFOV = 102
+)
+
+type MediaFormat uint8
+
+const (
+	MediaFormatTimeLapseVideo  MediaFormat = 13
+	MediaFormatTimeLapsePhoto  MediaFormat = 20
+	MediaFormatNightLapsePhoto MediaFormat = 21
+	MediaFormatNightLapseVideo MediaFormat = 26
+)
+
+type AntiFlicker uint8
+
+const (
+	AntiFlicker60Hz AntiFlicker = 2
+	AntiFlicker50Hz AntiFlicker = 3
+)
+
+type Hypersmooth uint8
+
+const (
+	HypersmoothOff       Hypersmooth = 0
+	HypersmoothLow       Hypersmooth = 1
+	HypersmoothHigh      Hypersmooth = 2
+	HypersmoothBoost     Hypersmooth = 3
+	HypersmoothAutoBoost Hypersmooth = 4
+	HypersmoothStandard  Hypersmooth = 100
+)
+
+type HorizonLeveling uint8
+
+const (
+	HorizonLevelingOff    HorizonLeveling = 0
+	HorizonLevelingLocked HorizonLeveling = 2
+)
+
+type HindSight uint8
+
+const (
+	HindSight15Seconds HindSight = 2
+	HindSight30Seconds HindSight = 3
+	HindSightOff       HindSight = 4
+)
+
+type PhotoSingleInterval uint8
+
+const (
+	PhotoSingleIntervalOff        PhotoSingleInterval = 0
+	PhotoSingleIntervalHalfSecond PhotoSingleInterval = 2
+	PhotoSingleInterval1Second    PhotoSingleInterval = 3
+	PhotoSingleInterval2Seconds   PhotoSingleInterval = 4
+	PhotoSingleInterval5Seconds   PhotoSingleInterval = 5
+	PhotoSingleInterval10Seconds  PhotoSingleInterval = 6
+	PhotoSingleInterval30Seconds  PhotoSingleInterval = 7
+	PhotoSingleInterval60Seconds  PhotoSingleInterval = 8
+	PhotoSingleInterval120Seconds PhotoSingleInterval = 9
+	PhotoSingleInterval3Seconds   PhotoSingleInterval = 10
+)
+
+type PhotoIntervalDuration uint8
+
+const (
+	PhotoIntervalDurationOff       PhotoIntervalDuration = 0
+	PhotoIntervalDuration15Seconds PhotoIntervalDuration = 1
+	PhotoIntervalDuration30Seconds PhotoIntervalDuration = 2
+	PhotoIntervalDuration1Minute   PhotoIntervalDuration = 3
+	PhotoIntervalDuration5Minutes  PhotoIntervalDuration = 4
+	PhotoIntervalDuration15Minutes PhotoIntervalDuration = 5
+	PhotoIntervalDuration30Minutes PhotoIntervalDuration = 6
+	PhotoIntervalDuration1Hour     PhotoIntervalDuration = 7
+	PhotoIntervalDuration2Hours    PhotoIntervalDuration = 8
+	PhotoIntervalDuration3Hours    PhotoIntervalDuration = 9
+)
+
+type VideoPerformanceMode uint8
+
+const (
+	VideoPerformanceModeMaxPerformance   VideoPerformanceMode = 0
+	VideoPerformanceModeExtendedBattery  VideoPerformanceMode = 1
+	VideoPerformanceModeTripodStationary VideoPerformanceMode = 2
+)
+
+type Controls uint8
+
+const (
+	ControlsEasy Controls = 0
+	ControlsPro  Controls = 1
+)
+
+type EasyModeSpeed uint8
+
+const (
+	EasyModeSpeed8xUltraSloMo EasyModeSpeed = 0
+	EasyModeSpeed4xSuperSloMo EasyModeSpeed = 1
+	EasyModeSpeed2xSloMo      EasyModeSpeed = 2
+	EasyModeSpeed1xLowLight   EasyModeSpeed = 3
+)
+
+type WirelessBand uint8
+
+const (
+	WirelessBand2Point4GHz WirelessBand = 0
+	WirelessBand5GHz       WirelessBand = 1
+)
+
+type TrailLength uint8
+
+const (
+	TrailLengthShort TrailLength = 1
+	TrailLengthLong  TrailLength = 2
+	TrailLengthMax   TrailLength = 3
+)
+
+type VideoMode uint8
+
+const (
+	VideoModeHighestQuality           VideoMode = 0
+	VideoModeExtendedBattery          VideoMode = 101
+	VideoModeExtendedBatteryGreenIcon VideoMode = 102
+	VideoModeLongestBatteryGreenIcon  VideoMode = 103
+)
+
+type BitRate uint8
+
+const (
+	BitRateStandard BitRate = 0
+	BitRateHigh     BitRate = 1
+)
+
+type BitDepth uint8
+
+const (
+	BitDepth8Bit  BitDepth = 0
+	BitDepth10Bit BitDepth = 2
+)
+
+type Profile uint8
+
+const (
+	ProfileStandard Profile = 0
+	ProfileHDR      Profile = 1
+	ProfileLog      Profile = 2
+)
+
+type VideoQuality uint8
+
+const (
+	VideoQualityHighest  VideoQuality = 0
+	VideoQualityStandard VideoQuality = 1
+	VideoQualityBasic    VideoQuality = 2
+)
+
+type LapseMode uint8
+
+const (
+	LapseModeTimeWarp         LapseMode = 0
+	LapseModeStarTrails       LapseMode = 1
+	LapseModeLightPainting    LapseMode = 2
+	LapseModeVehicleLights    LapseMode = 3
+	LapseModeMaxTimeWarp      LapseMode = 4
+	LapseModeMaxStarTrails    LapseMode = 5
+	LapseModeMaxLightPainting LapseMode = 6
+	LapseModeMaxVehicleLights LapseMode = 7
+)
+
+type MaxLensMod uint8
+
+const (
+	MaxLensModNone     MaxLensMod = 0
+	MaxLensModMaxLens1 MaxLensMod = 1
+	MaxLensModMaxLens2 MaxLensMod = 2
+)
+
+type PhotoMode uint8
+
+const (
+	PhotoModeSuperPhoto PhotoMode = 0
+	PhotoModeNightPhoto PhotoMode = 1
+)
+
+type AspectRatio uint8
+
+const (
+	AspectRatio4x3  AspectRatio = 0
+	AspectRatio16x9 AspectRatio = 1
+	AspectRatio8x7  AspectRatio = 3
+	AspectRatio9x16 AspectRatio = 4
+)
+
+type Framing uint8
+
+const (
+	FramingWidescreen Framing = 0
+	FramingVertical   Framing = 1
+	FramingFullFrame  Framing = 2
+)
@@ -0,0 +1,522 @@
+// In-process camera implementing transport.Transport, for exercising control software without any hardware
+package simulator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/command"
+	"github.com/thatpix3l/persephone/pkg/packet"
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/settings"
+	"github.com/thatpix3l/persephone/pkg/transport"
+)
+
+// Returned by Write once the simulated camera has gone to sleep or been closed
+var ErrDisconnected = errors.New("simulated camera is disconnected")
+
+// Identifies a scripted failure
+type failureKey struct {
+	channel transport.Channel
+	id      byte
+}
+
+// Simulated camera, answering commands, setting changes and queries the way a real camera would
+type Simulator struct {
+	writeMu sync.Mutex // Serializes writes, so notifications are delivered in the order requests arrive
+
+	mu           sync.Mutex
+	statuses     query.Response
+	settings     map[settings.ID]byte
+	hardware     command.Hardware
+	clockOffset  time.Duration // Offset of the simulated clock from the host's
+	handlers     map[transport.Channel]transport.NotificationHandler
+	accumulators packet.Accumulators[transport.Channel]
+	statusSubs   map[query.StatusID]bool
+	settingSubs  map[settings.ID]bool
+	failures     map[failureKey][]settings.Result
+	mtu          int
+	disconnected bool
+}
+
+// Return a simulated camera that is idle, ready for commands and fully charged
+func New() *Simulator {
+
+	s := &Simulator{
+		settings:    map[settings.ID]byte{},
+		handlers:    map[transport.Channel]transport.NotificationHandler{},
+		statusSubs:  map[query.StatusID]bool{},
+		settingSubs: map[settings.ID]bool{},
+		failures:    map[failureKey][]settings.Result{},
+		mtu:         packet.DefaultMTU,
+		hardware: command.Hardware{
+			ModelNumber:     "0:0:0:3e",
+			ModelName:       "HERO Simulator",
+			Board:           "sim",
+			FirmwareVersion: "H00.00.00.00.00",
+			SerialNumber:    "C0000000000000",
+			SSID:            "GP00000000",
+			SSIDMacAddress:  "0:0:0:0:0:0",
+		},
+	}
+
+	s.statuses.HasInternalBattery = true
+	s.statuses.BatteryLevelBars = 3
+	s.statuses.InternalBatteryPercent = 100
+	s.statuses.IsReadyForCommands = true
+
+	s.settings[settings.IDVideoResolution] = byte(settings.Res4K)
+	s.settings[settings.IDFPS] = byte(settings.FPS30)
+	s.settings[settings.IDVideoFOV] = byte(settings.VideoFOVWide)
+	s.settings[settings.IDAutoPowerDown] = byte(settings.AutoPowerDownNever)
+
+	return s
+
+}
+
+// Fragment every notification to at most "mtu" bytes per packet, to exercise reassembly. Errors if "mtu" is less than packet.MinMTU.
+func (s *Simulator) SetMTU(mtu int) error {
+
+	if mtu < packet.MinMTU {
+		return fmt.Errorf("mtu %d is less than minimum of %d", mtu, packet.MinMTU)
+	}
+
+	s.mu.Lock()
+	s.mtu = mtu
+	s.mu.Unlock()
+
+	return nil
+
+}
+
+// Answer the next request with the given command, setting or query ID on "channel" with "result", instead of handling it.
+// Calling several times queues failures for consecutive requests.
+func (s *Simulator) FailNext(channel transport.Channel, id byte, result settings.Result) {
+	s.mu.Lock()
+	key := failureKey{channel: channel, id: id}
+	s.failures[key] = append(s.failures[key], result)
+	s.mu.Unlock()
+}
+
+// Mark the camera busy, failing every command until marked otherwise, and push the change to anyone registered for it
+func (s *Simulator) SetBusy(busy bool) {
+	s.UpdateStatuses(func(r *query.Response) {
+		r.IsBusy = busy
+		r.IsReadyForCommands = !busy
+	})
+}
+
+// Change the simulated camera's statuses, pushing every changed status to anyone registered for it
+func (s *Simulator) UpdateStatuses(update func(r *query.Response)) {
+
+	s.mu.Lock()
+	before := s.statuses
+	update(&s.statuses)
+	notifications := s.statusPushes(before)
+	s.mu.Unlock()
+
+	for _, n := range notifications {
+		s.deliver(n.channel, n.packets)
+	}
+
+}
+
+// Return a copy of the simulated camera's statuses
+func (s *Simulator) Statuses() query.Response {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.statuses
+}
+
+// Return the raw value of a setting, and whether the setting has ever been set
+func (s *Simulator) Setting(id settings.ID) (byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.settings[id]
+	return value, ok
+}
+
+// Return the current time of the simulated camera's clock
+func (s *Simulator) Clock() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return time.Now().Add(s.clockOffset)
+}
+
+// Return true until the simulated camera goes to sleep or is closed
+func (s *Simulator) Connected() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.disconnected
+}
+
+// Deliver raw packets on "channel" as is, for injecting malformed or out of order notifications
+func (s *Simulator) Inject(channel transport.Channel, packets [][]byte) {
+	s.deliver(channel, packets)
+}
+
+func (s *Simulator) Subscribe(channel transport.Channel, handler transport.NotificationHandler) error {
+	s.mu.Lock()
+	s.handlers[channel] = handler
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Simulator) Close() error {
+	s.mu.Lock()
+	s.disconnected = true
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Simulator) Write(channel transport.Channel, packets [][]byte) error {
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	for _, p := range packets {
+
+		s.mu.Lock()
+		if s.disconnected {
+			s.mu.Unlock()
+			return ErrDisconnected
+		}
+
+		message, err := s.accumulators.Accumulate(channel, p)
+		if err != nil || message == nil {
+			s.mu.Unlock()
+			if err != nil {
+				return fmt.Errorf("simulated camera rejected packet: %w", err)
+			}
+			continue
+		}
+
+		responses := s.handle(channel, message)
+		s.mu.Unlock()
+
+		for _, response := range responses {
+			s.deliver(response.channel, response.packets)
+		}
+
+	}
+
+	return nil
+
+}
+
+// Notification packets waiting to be delivered
+type notification struct {
+	channel transport.Channel
+	packets [][]byte
+}
+
+// Fragment "message" for delivery on "channel", must be called with the lock held
+func (s *Simulator) frame(channel transport.Channel, message []byte) notification {
+
+	packets, err := packet.Fragment(message, s.mtu)
+	if err != nil {
+		// Responses are built by the simulator itself, so this is a bug in the simulator
+		panic(err)
+	}
+
+	return notification{channel: channel, packets: packets}
+
+}
+
+// Hand packets to the channel's subscriber, must be called without the lock held
+func (s *Simulator) deliver(channel transport.Channel, packets [][]byte) {
+
+	s.mu.Lock()
+	handler := s.handlers[channel]
+	s.mu.Unlock()
+
+	if handler == nil {
+		return
+	}
+
+	for _, p := range packets {
+		handler(p)
+	}
+
+}
+
+// Handle a complete request message, returning the notifications it causes, must be called with the lock held
+func (s *Simulator) handle(channel transport.Channel, message []byte) []notification {
+
+	id := message[0]
+
+	if failures := s.failures[failureKey{channel: channel, id: id}]; len(failures) > 0 {
+		s.failures[failureKey{channel: channel, id: id}] = failures[1:]
+		return []notification{s.frame(channel, []byte{id, byte(failures[0])})}
+	}
+
+	switch channel {
+	case transport.Command:
+		return s.handleCommand(message)
+	case transport.Settings:
+		return s.handleSetting(message)
+	case transport.Query:
+		return s.handleQuery(message)
+	}
+
+	return nil
+
+}
+
+// Return the parameters of a TLV command message, [id, length, parameters...]
+func commandParameters(message []byte) []byte {
+	if len(message) < 2 || len(message)-2 < int(message[1]) {
+		return nil
+	}
+	return message[2 : 2+int(message[1])]
+}
+
+// Decode the [year, year, month, day, hour, minute, second] parameters shared by the date time commands
+func parseDateTime(parameters []byte, location *time.Location) (time.Time, error) {
+
+	if len(parameters) < 7 {
+		return time.Time{}, fmt.Errorf("date time parameters are shorter than minimum of 7: %v", parameters)
+	}
+
+	return time.Date(
+		int(binary.BigEndian.Uint16(parameters[0:2])),
+		time.Month(parameters[2]),
+		int(parameters[3]),
+		int(parameters[4]),
+		int(parameters[5]),
+		int(parameters[6]),
+		0,
+		location,
+	), nil
+
+}
+
+// Encode a length prefixed string, as used by the hardware info response
+func lengthPrefixed(value []byte) []byte {
+	return append([]byte{byte(len(value))}, value...)
+}
+
+func (s *Simulator) handleCommand(message []byte) []notification {
+
+	id := message[0]
+	parameters := commandParameters(message)
+	result := []byte{id, byte(settings.ResultSuccess)}
+	before := s.statuses
+
+	if s.statuses.IsBusy {
+		return []notification{s.frame(transport.Command, []byte{id, byte(settings.ResultError)})}
+	}
+
+	switch id {
+
+	case 0x01:
+		if len(parameters) != 1 || parameters[0] > 1 {
+			result[1] = byte(settings.ResultInvalidParameter)
+			break
+		}
+		s.statuses.IsEncoding = parameters[0] == 1
+
+	case 0x05:
+		// Answer, then drop off the air like a real camera going to sleep
+		s.disconnected = true
+
+	case 0x0d, 0x0f:
+		// The simulator shares the host's time zone, so any offset sent along with a local date time is ignored
+		t, err := parseDateTime(parameters, time.Local)
+		if err != nil {
+			result[1] = byte(settings.ResultInvalidParameter)
+			break
+		}
+		s.clockOffset = time.Until(t)
+
+	case 0x0e, 0x10:
+		now := time.Now().Add(s.clockOffset).In(time.Local)
+		year := make([]byte, 2)
+		binary.BigEndian.PutUint16(year, uint16(now.Year()))
+		value := append(year, byte(now.Month()), byte(now.Day()), byte(now.Hour()), byte(now.Minute()), byte(now.Second()))
+		result = append(result, lengthPrefixed(value)...)
+
+	case 0x17:
+		if len(parameters) != 1 || parameters[0] > 1 {
+			result[1] = byte(settings.ResultInvalidParameter)
+			break
+		}
+		s.statuses.IsWifiRadioEnabled = parameters[0] == 1
+
+	case 0x18:
+		if !s.statuses.IsEncoding {
+			result[1] = byte(settings.ResultError)
+			break
+		}
+		s.statuses.TagHilightsCount++
+
+	case 0x3c:
+		modelNumber := make([]byte, 4)
+		var a, b, c, d byte
+		fmt.Sscanf(s.hardware.ModelNumber, "%x:%x:%x:%x", &a, &b, &c, &d)
+		modelNumber[0], modelNumber[1], modelNumber[2], modelNumber[3] = a, b, c, d
+		mac := make([]byte, 6)
+		fmt.Sscanf(s.hardware.SSIDMacAddress, "%x:%x:%x:%x:%x:%x", &mac[0], &mac[1], &mac[2], &mac[3], &mac[4], &mac[5])
+		result = append(result, lengthPrefixed(modelNumber)...)
+		result = append(result, lengthPrefixed([]byte(s.hardware.ModelName))...)
+		result = append(result, lengthPrefixed([]byte(s.hardware.Board))...)
+		result = append(result, lengthPrefixed([]byte(s.hardware.FirmwareVersion))...)
+		result = append(result, lengthPrefixed([]byte(s.hardware.SerialNumber))...)
+		result = append(result, lengthPrefixed([]byte(s.hardware.SSID))...)
+		result = append(result, lengthPrefixed(mac)...)
+
+	case 0x3e:
+		if len(parameters) != 2 {
+			result[1] = byte(settings.ResultInvalidParameter)
+			break
+		}
+		s.statuses.PresetGroupID = uint(binary.BigEndian.Uint16(parameters))
+
+	case 0x40:
+		if len(parameters) != 4 {
+			result[1] = byte(settings.ResultInvalidParameter)
+			break
+		}
+		s.statuses.PresetID = uint(binary.BigEndian.Uint32(parameters))
+
+	case 0x50:
+
+	case 0x51:
+		result = append(result, 1, 2, 1, 0)
+
+	default:
+		result[1] = byte(settings.ResultInvalidParameter)
+
+	}
+
+	notifications := []notification{s.frame(transport.Command, result)}
+	return append(notifications, s.statusPushes(before)...)
+
+}
+
+func (s *Simulator) handleSetting(message []byte) []notification {
+
+	id := settings.ID(message[0])
+	parameters := commandParameters(message)
+
+	if len(parameters) != 1 {
+		return []notification{s.frame(transport.Settings, []byte{message[0], byte(settings.ResultInvalidParameter)})}
+	}
+
+	if s.statuses.IsEncoding || s.statuses.IsBusy {
+		return []notification{s.frame(transport.Settings, []byte{message[0], byte(settings.ResultError)})}
+	}
+
+	changed := s.settings[id] != parameters[0]
+	s.settings[id] = parameters[0]
+
+	notifications := []notification{s.frame(transport.Settings, []byte{message[0], byte(settings.ResultSuccess)})}
+	if changed && s.settingSubs[id] {
+		notifications = append(notifications, s.frame(transport.Query, append([]byte{query.IDSettingUpdate, byte(settings.ResultSuccess)}, byte(id), 1, parameters[0])))
+	}
+
+	return notifications
+
+}
+
+func (s *Simulator) handleQuery(message []byte) []notification {
+
+	queryID := message[0]
+	ids := message[1:]
+	result := []byte{queryID, byte(settings.ResultSuccess)}
+
+	switch queryID {
+
+	case query.IDGetStatusValues, query.IDRegisterStatusUpdates:
+		if len(ids) == 0 && queryID == query.IDGetStatusValues {
+			ids = s.statusIDs()
+		}
+		for _, id := range ids {
+			if queryID == query.IDRegisterStatusUpdates {
+				s.statusSubs[query.StatusID(id)] = true
+			}
+			result = append(result, encodeStatus(&s.statuses, query.StatusID(id))...)
+		}
+
+	case query.IDUnregisterStatusUpdates:
+		for _, id := range ids {
+			delete(s.statusSubs, query.StatusID(id))
+		}
+
+	case query.IDGetSettingValues, query.IDRegisterSettingUpdates:
+		if len(ids) == 0 && queryID == query.IDGetSettingValues {
+			ids = s.settingIDs()
+		}
+		for _, id := range ids {
+			if queryID == query.IDRegisterSettingUpdates {
+				s.settingSubs[settings.ID(id)] = true
+			}
+			if value, ok := s.settings[settings.ID(id)]; ok {
+				result = append(result, id, 1, value)
+			}
+		}
+
+	case query.IDUnregisterSettingUpdates:
+		for _, id := range ids {
+			delete(s.settingSubs, settings.ID(id))
+		}
+
+	case query.IDGetSettingCapabilities:
+
+	default:
+		result[1] = byte(settings.ResultInvalidParameter)
+
+	}
+
+	return []notification{s.frame(transport.Query, result)}
+
+}
+
+// Return the ID of every setting that has a value, in ascending order
+func (s *Simulator) settingIDs() []byte {
+
+	ids := []byte{}
+	for id := range s.settings {
+		ids = append(ids, byte(id))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+
+}
+
+// Return the status updates to push for every registered status that differs from "before", must be called with the lock held
+func (s *Simulator) statusPushes(before query.Response) []notification {
+
+	if before == s.statuses {
+		return nil
+	}
+
+	message := []byte{query.IDStatusUpdate, byte(settings.ResultSuccess)}
+
+	for _, id := range s.statusIDs() {
+
+		if !s.statusSubs[query.StatusID(id)] {
+			continue
+		}
+
+		old := encodeStatus(&before, query.StatusID(id))
+		current := encodeStatus(&s.statuses, query.StatusID(id))
+
+		if string(old) != string(current) {
+			message = append(message, current...)
+		}
+
+	}
+
+	if len(message) == 2 {
+		return nil
+	}
+
+	return []notification{s.frame(transport.Query, message)}
+
+}
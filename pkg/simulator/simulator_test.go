@@ -0,0 +1,23 @@
+package simulator
+
+import (
+	"testing"
+
+	"github.com/thatpix3l/persephone/pkg/packet"
+)
+
+func TestSetMTU(t *testing.T) {
+
+	s := New()
+
+	for _, mtu := range []int{0, packet.MinMTU - 1} {
+		if err := s.SetMTU(mtu); err == nil {
+			t.Errorf("SetMTU(%d) did not error", mtu)
+		}
+	}
+
+	if err := s.SetMTU(packet.MinMTU); err != nil {
+		t.Error(err)
+	}
+
+}
@@ -0,0 +1,24 @@
+package simulator
+
+import (
+	"github.com/thatpix3l/persephone/pkg/query"
+)
+
+// Return the ID of every status the simulator can report, in ascending order
+func (s *Simulator) statusIDs() []byte {
+	fields := query.Fields()
+	ids := make([]byte, len(fields))
+	for i, field := range fields {
+		ids[i] = byte(field.ID)
+	}
+	return ids
+}
+
+// Encode a status as a [status_ID, count_of_values, values...] triple, or nothing if the status does not exist
+func encodeStatus(r *query.Response, id query.StatusID) []byte {
+	partial, err := query.MarshalPartial(r, id)
+	if err != nil {
+		return nil
+	}
+	return partial
+}
@@ -0,0 +1,118 @@
+// Utilities for walking the [ID, length, value...] triples that GoPro packs its query and setting payloads with
+package tlv
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A single [ID, length, value...] triple
+type Entry struct {
+	ID    byte
+	Value []byte
+}
+
+// Return the entry as the byte sequence it was parsed from
+func (e Entry) Bytes() []byte {
+	return append([]byte{e.ID, byte(len(e.Value))}, e.Value...)
+}
+
+// Split "data" into consecutive entries.
+//
+// Stops at the first entry whose header or value is cut short, returning every entry before it along with an error naming the truncated ID.
+func Split(data []byte) ([]Entry, error) {
+
+	entries := []Entry{}
+
+	for len(data) > 0 {
+
+		if len(data) < 2 {
+			return entries, &TruncatedError{ID: data[0], Data: data}
+		}
+
+		length := int(data[1])
+		if len(data)-2 < length {
+			return entries, &TruncatedError{ID: data[0], Data: data}
+		}
+
+		entries = append(entries, Entry{ID: data[0], Value: data[2 : 2+length]})
+		data = data[2+length:]
+
+	}
+
+	return entries, nil
+
+}
+
+// Returned when the remaining bytes are too short for the entry they begin
+type TruncatedError struct {
+	ID   byte
+	Data []byte
+}
+
+func (e *TruncatedError) Error() string {
+	return fmt.Sprintf("entry with ID %d is truncated: %v", e.ID, e.Data)
+}
+
+// Outcome of decoding a list of entries, by ID
+type Report[ID ~uint8] struct {
+	Present   []ID         // Entries decoded
+	Unknown   []ID         // Entries whose ID is not known, left untouched
+	Malformed map[ID]error // Entries whose value could not be decoded, and why
+}
+
+// Return true if every entry was decoded
+func (r *Report[ID]) OK() bool {
+	return len(r.Unknown) == 0 && len(r.Malformed) == 0
+}
+
+// Record the outcome of decoding the entry with "id", where "err" wrapping "unknown" means the ID is not known
+func (r *Report[ID]) Record(id ID, err error, unknown error) {
+
+	if errors.Is(err, unknown) {
+		r.Unknown = append(r.Unknown, id)
+
+	} else if err != nil {
+		if r.Malformed == nil {
+			r.Malformed = map[ID]error{}
+		}
+		r.Malformed[id] = err
+
+	} else {
+		r.Present = append(r.Present, id)
+
+	}
+
+}
+
+// Decode every entry of "data" with "decode", which returns an error wrapping "unknown" for IDs it does not know.
+//
+// Every entry is decoded independently, so an unknown or malformed entry does not prevent the rest from being decoded, and is instead recorded in the returned report.
+// A truncated entry ends the walk, and is recorded as malformed.
+func Walk[ID ~uint8](data []byte, unknown error, decode func(Entry) error) Report[ID] {
+
+	report := Report[ID]{Malformed: map[ID]error{}}
+
+	entries, splitErr := Split(data)
+
+	for _, entry := range entries {
+		report.Record(ID(entry.ID), decode(entry), unknown)
+	}
+
+	var truncatedErr *TruncatedError
+	if errors.As(splitErr, &truncatedErr) {
+		report.Malformed[ID(truncatedErr.ID)] = splitErr
+	}
+
+	return report
+
+}
+
+// Return "ids" as the bytes a request lists them with
+func IDs[ID ~uint8](ids []ID) []byte {
+	buf := make([]byte, len(ids))
+	for i, id := range ids {
+		buf[i] = byte(id)
+	}
+	return buf
+}
@@ -0,0 +1,215 @@
+//go:build ble
+
+// Transport to a camera over Bluetooth Low Energy, built on tinygo bluetooth.
+// Only built with the "ble" build tag, keeping its platform bluetooth stack out of builds that do not need it.
+package ble
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/transport"
+	"tinygo.org/x/bluetooth"
+)
+
+// Service exposing the command, settings and query characteristics
+var ServiceUUID = bluetooth.New16BitUUID(0xfea6)
+
+// Service exposing the network management characteristics
+var NetworkManagementServiceUUID = characteristicUUID(0x90)
+
+// UUID of the GoPro service or characteristic numbered "n", e.g. 0x72 for GP-0072
+func characteristicUUID(n uint16) bluetooth.UUID {
+	uuid, err := bluetooth.ParseUUID(fmt.Sprintf("b5f9%04x-aa8d-11e3-9046-0002a5d5c51b", n))
+	if err != nil {
+		// The template above is always a well-formed UUID
+		panic(err)
+	}
+	return uuid
+}
+
+// Request and response characteristics of each channel
+var channelUUIDs = map[transport.Channel][2]bluetooth.UUID{
+	transport.Command:  {characteristicUUID(0x72), characteristicUUID(0x73)},
+	transport.Settings: {characteristicUUID(0x74), characteristicUUID(0x75)},
+	transport.Query:    {characteristicUUID(0x76), characteristicUUID(0x77)},
+
+	transport.NetworkManagement: {characteristicUUID(0x91), characteristicUUID(0x92)},
+}
+
+// Prefix of the name every camera advertises itself with
+const localNamePrefix = "GoPro "
+
+type characteristics struct {
+	request  bluetooth.DeviceCharacteristic
+	response bluetooth.DeviceCharacteristic
+}
+
+// Connection to a single camera, implementing transport.Transport
+type Transport struct {
+	device          *bluetooth.Device
+	characteristics map[transport.Channel]characteristics
+
+	handlersMu sync.RWMutex
+	handlers   map[transport.Channel]transport.NotificationHandler
+}
+
+// Scan for the first advertising camera, giving up after "timeout"
+func Scan(adapter *bluetooth.Adapter, timeout time.Duration) (bluetooth.ScanResult, error) {
+
+	var found *bluetooth.ScanResult
+
+	timer := time.AfterFunc(timeout, func() {
+		adapter.StopScan()
+	})
+	defer timer.Stop()
+
+	err := adapter.Scan(func(adapter *bluetooth.Adapter, result bluetooth.ScanResult) {
+		if result.HasServiceUUID(ServiceUUID) || strings.HasPrefix(result.LocalName(), localNamePrefix) {
+			found = &result
+			adapter.StopScan()
+		}
+	})
+	if err != nil {
+		return bluetooth.ScanResult{}, err
+	}
+
+	if found == nil {
+		return bluetooth.ScanResult{}, fmt.Errorf("no camera found within %v", timeout)
+	}
+
+	return *found, nil
+
+}
+
+// Connect to the camera at "address", discover its characteristics and enable notifications on every response characteristic.
+//
+// The adapter must already be enabled, and the camera paired with the host.
+func Connect(adapter *bluetooth.Adapter, address bluetooth.Addresser) (*Transport, error) {
+
+	device, err := adapter.Connect(address, bluetooth.ConnectionParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := newTransport(device)
+	if err != nil {
+		device.Disconnect()
+		return nil, err
+	}
+
+	return t, nil
+
+}
+
+func newTransport(device *bluetooth.Device) (*Transport, error) {
+
+	services, err := device.DiscoverServices([]bluetooth.UUID{ServiceUUID, NetworkManagementServiceUUID})
+	if err != nil {
+		return nil, err
+	}
+	if len(services) == 0 {
+		return nil, errors.New("camera does not expose the control and query service")
+	}
+
+	// Index discovered characteristics of every service by UUID
+	byUUID := map[bluetooth.UUID]bluetooth.DeviceCharacteristic{}
+	for _, service := range services {
+
+		chars, err := service.DiscoverCharacteristics(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, char := range chars {
+			byUUID[char.UUID()] = char
+		}
+
+	}
+
+	t := &Transport{
+		device:          device,
+		characteristics: map[transport.Channel]characteristics{},
+		handlers:        map[transport.Channel]transport.NotificationHandler{},
+	}
+
+	for channel, uuids := range channelUUIDs {
+
+		request, ok := byUUID[uuids[0]]
+		if !ok {
+			return nil, fmt.Errorf("%v request characteristic %v not found", channel, uuids[0])
+		}
+
+		response, ok := byUUID[uuids[1]]
+		if !ok {
+			return nil, fmt.Errorf("%v response characteristic %v not found", channel, uuids[1])
+		}
+
+		t.characteristics[channel] = characteristics{request: request, response: response}
+
+		channel := channel
+		if err := response.EnableNotifications(func(buf []byte) {
+			t.notify(channel, buf)
+		}); err != nil {
+			return nil, fmt.Errorf("enabling %v notifications: %w", channel, err)
+		}
+
+	}
+
+	return t, nil
+
+}
+
+// Hand a notification to the channel's current handler, if any
+func (t *Transport) notify(channel transport.Channel, buf []byte) {
+
+	t.handlersMu.RLock()
+	handler := t.handlers[channel]
+	t.handlersMu.RUnlock()
+
+	if handler == nil {
+		return
+	}
+
+	// The buffer may be reused by the underlying stack once the callback returns
+	handler(append([]byte{}, buf...))
+
+}
+
+func (t *Transport) Write(channel transport.Channel, packets [][]byte) error {
+
+	chars, ok := t.characteristics[channel]
+	if !ok {
+		return fmt.Errorf("channel %v is not supported", channel)
+	}
+
+	for _, packet := range packets {
+		if _, err := chars.request.WriteWithoutResponse(packet); err != nil {
+			return fmt.Errorf("writing to %v: %w", channel, err)
+		}
+	}
+
+	return nil
+
+}
+
+func (t *Transport) Subscribe(channel transport.Channel, handler transport.NotificationHandler) error {
+
+	if _, ok := t.characteristics[channel]; !ok {
+		return fmt.Errorf("channel %v is not supported", channel)
+	}
+
+	t.handlersMu.Lock()
+	t.handlers[channel] = handler
+	t.handlersMu.Unlock()
+
+	return nil
+
+}
+
+func (t *Transport) Close() error {
+	return t.device.Disconnect()
+}
@@ -0,0 +1,50 @@
+// Interfaces for moving packets between the host and a camera, independent of the link carrying them
+package transport
+
+import (
+	"fmt"
+)
+
+// A request characteristic written to by the host, paired with the response characteristic the camera notifies the host on
+type Channel int
+
+const (
+	Command  Channel = iota // Commands, as built by the command package
+	Settings                // Setting changes, as built by the settings package
+	Query                   // Queries, as built by the query package
+
+	NetworkManagement // Network management requests, as built by the proto package
+)
+
+// Every channel a transport is expected to carry
+var Channels = []Channel{Command, Settings, Query, NetworkManagement}
+
+func (c Channel) String() string {
+	switch c {
+	case Command:
+		return "Command"
+	case Settings:
+		return "Settings"
+	case Query:
+		return "Query"
+	case NetworkManagement:
+		return "Network Management"
+	}
+	return fmt.Sprintf("Channel(%d)", int(c))
+}
+
+// Called with every raw notification received on a channel's response characteristic, still framed as a packet
+type NotificationHandler func(packet []byte)
+
+type Transport interface {
+
+	// Write packets, in order, to the channel's request characteristic
+	Write(channel Channel, packets [][]byte) error
+
+	// Call "handler" with every notification received on the channel's response characteristic, replacing any previous handler.
+	// A nil handler drops notifications.
+	Subscribe(channel Channel, handler NotificationHandler) error
+
+	// Disconnect from the camera
+	Close() error
+}
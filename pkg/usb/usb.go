@@ -0,0 +1,80 @@
+// Control of a camera over the network interface it exposes on USB, reusing the HTTP client
+package usb
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+
+	"github.com/thatpix3l/persephone/pkg/command"
+	"github.com/thatpix3l/persephone/pkg/http"
+	"github.com/thatpix3l/persephone/pkg/query"
+)
+
+// Port of the camera's HTTP API on its USB network interface
+const Port = 8080
+
+// Return the camera's address on its USB network interface, 172.2X.1YZ.51 where XYZ are the last 3 digits of its serial number
+func Address(serialNumber string) (string, error) {
+
+	if len(serialNumber) < 3 {
+		return "", fmt.Errorf("serial number %q is shorter than 3 digits", serialNumber)
+	}
+
+	digits := serialNumber[len(serialNumber)-3:]
+	for _, d := range digits {
+		if d < '0' || d > '9' {
+			return "", fmt.Errorf("serial number %q does not end in 3 digits", serialNumber)
+		}
+	}
+
+	return fmt.Sprintf("172.2%c.1%c%c.51", digits[0], digits[1], digits[2]), nil
+
+}
+
+// Return the base URL of the camera's HTTP API on its USB network interface
+func URL(serialNumber string) (string, error) {
+
+	address, err := Address(serialNumber)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://%s:%d", address, Port), nil
+
+}
+
+// Return an HTTP client for the camera identified by "hardware", as reported by GetHardwareInfo, reached over USB.
+// A nil client is replaced as by http.New.
+func New(hardware command.Hardware, client *nethttp.Client) (*http.Client, error) {
+
+	base, err := URL(hardware.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.New(base, client)
+
+}
+
+// Return an HTTP client for the camera identified by "hardware" reached over USB, enabling wired control so it can be driven with BLE off.
+//
+// Errors if the camera's statuses, as last received over BLE, show it is not connected over USB, or wired control cannot be enabled.
+func Connect(ctx context.Context, hardware command.Hardware, statuses query.Response, client *nethttp.Client) (*http.Client, error) {
+
+	if !statuses.IsConnectedViaUSB {
+		return nil, fmt.Errorf("camera %s is not connected over USB", hardware.SerialNumber)
+	}
+
+	c, err := New(hardware, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.SetWiredUSBControl(ctx, true); err != nil {
+		return nil, fmt.Errorf("enabling wired control: %w", err)
+	}
+
+	return c, nil
+
+}
@@ -0,0 +1,231 @@
+// Control of the camera's webcam mode over HTTP, streaming over USB or Wi-Fi
+package webcam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/thatpix3l/persephone/pkg/http"
+	"github.com/thatpix3l/persephone/pkg/proto"
+	"github.com/thatpix3l/persephone/pkg/query"
+	"github.com/thatpix3l/persephone/pkg/settings"
+)
+
+// Interval between keep-alives that stops the camera from leaving webcam mode
+const DefaultKeepAliveInterval = 3 * time.Second
+
+// Returned by Reachable
+var (
+	ErrUSBControlDisabled = errors.New("camera is connected over USB, but wired control is disabled")
+	ErrWirelessDisabled   = errors.New("camera is not connected over USB, and its wireless connections are disabled")
+)
+
+// Return nil if the camera can be controlled as a webcam given its statuses, otherwise why not
+func Reachable(s query.Response) error {
+
+	if s.IsConnectedViaUSB {
+		if s.UsbControlStaus != query.UsbControlStatusEnabled {
+			return ErrUSBControlDisabled
+		}
+		return nil
+	}
+
+	if !s.IsWirelessConnectionsEnabled {
+		return ErrWirelessDisabled
+	}
+
+	return nil
+
+}
+
+// Webcam state
+type Status int
+
+const (
+	StatusOff              Status = 0
+	StatusIdle             Status = 1
+	StatusHighPowerPreview Status = 2 // Streaming
+	StatusLowPowerPreview  Status = 3 // Previewing, see Webcam.Preview
+)
+
+var statusNames = map[Status]string{
+	StatusOff:              "Off",
+	StatusIdle:             "Idle",
+	StatusHighPowerPreview: "High Power Preview",
+	StatusLowPowerPreview:  "Low Power Preview",
+}
+
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// Webcam error, returned as is so callers can tell them apart with errors.As
+type Error int
+
+const (
+	ErrorNone          Error = 0
+	ErrorSetPreset     Error = 1
+	ErrorSetWindowSize Error = 2
+	ErrorExecStream    Error = 3
+	ErrorShutter       Error = 4
+	ErrorComTimeout    Error = 5
+	ErrorInvalidParam  Error = 6
+	ErrorUnavailable   Error = 7
+	ErrorExit          Error = 8
+)
+
+var errorNames = map[Error]string{
+	ErrorNone:          "None",
+	ErrorSetPreset:     "Set Preset",
+	ErrorSetWindowSize: "Set Window Size",
+	ErrorExecStream:    "Exec Stream",
+	ErrorShutter:       "Shutter",
+	ErrorComTimeout:    "Communication Timeout",
+	ErrorInvalidParam:  "Invalid Parameter",
+	ErrorUnavailable:   "Unavailable",
+	ErrorExit:          "Exit",
+}
+
+func (e Error) String() string {
+	if name, ok := errorNames[e]; ok {
+		return name
+	}
+	return fmt.Sprintf("Error(%d)", int(e))
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("webcam failed: %s", e.String())
+}
+
+// Protocol the webcam streams over
+type Protocol string
+
+const (
+	ProtocolTS   Protocol = "TS"   // MPEG-TS over UDP, receivable with the preview package
+	ProtocolRTSP Protocol = "RTSP" // RTSP, served by the camera at rtsp://<camera>:554/live
+)
+
+// Options of Start, left zero to let the camera choose
+type Options struct {
+	Resolution proto.EnumWindowSize
+	FOV        *settings.WebcamFOV // Nil to keep the current one, as zero is a valid FOV
+	Port       int                 // UDP port of the host to stream to over TS, preview.DefaultPort if zero
+	Protocol   Protocol
+}
+
+// Status of the webcam, as answered by every webcam endpoint
+type State struct {
+	Status Status `json:"status"`
+	Error  Error  `json:"error"`
+}
+
+// Return the webcam's error, or nil if it has none
+func (s State) Err() error {
+	if s.Error == ErrorNone {
+		return nil
+	}
+	return s.Error
+}
+
+type Webcam struct {
+	client *http.Client
+}
+
+// Return a webcam controlled through "client"
+func New(client *http.Client) *Webcam {
+	return &Webcam{client: client}
+}
+
+// Send a request to the webcam endpoint "action", returning the state it answers with and its error, if any
+func (w *Webcam) request(ctx context.Context, action string, query url.Values) (State, error) {
+
+	var s State
+	if err := w.client.WebcamRequest(ctx, action, query, &s); err != nil {
+
+		// Failures are answered with the same body, whose error is more telling than the status code
+		var statusErr *http.StatusError
+		if errors.As(err, &statusErr) && json.Unmarshal([]byte(statusErr.Body), &s) == nil && s.Error != ErrorNone {
+			return s, s.Error
+		}
+
+		return s, err
+
+	}
+
+	return s, s.Err()
+
+}
+
+// Enter webcam mode if needed, and start streaming
+func (w *Webcam) Start(ctx context.Context, options Options) (State, error) {
+
+	query := url.Values{}
+	if options.Resolution != 0 {
+		query.Set("res", strconv.Itoa(int(options.Resolution)))
+	}
+	if options.FOV != nil {
+		query.Set("fov", strconv.Itoa(int(*options.FOV)))
+	}
+	if options.Port != 0 {
+		query.Set("port", strconv.Itoa(options.Port))
+	}
+	if options.Protocol != "" {
+		query.Set("protocol", string(options.Protocol))
+	}
+
+	return w.request(ctx, "start", query)
+
+}
+
+// Start a low power preview, for framing shots before streaming
+func (w *Webcam) Preview(ctx context.Context) (State, error) {
+	return w.request(ctx, "preview", nil)
+}
+
+// Stop streaming, staying in webcam mode
+func (w *Webcam) Stop(ctx context.Context) (State, error) {
+	return w.request(ctx, "stop", nil)
+}
+
+// Leave webcam mode
+func (w *Webcam) Exit(ctx context.Context) (State, error) {
+	return w.request(ctx, "exit", nil)
+}
+
+// Get the webcam's status and error, without changing either
+func (w *Webcam) GetStatus(ctx context.Context) (State, error) {
+	return w.request(ctx, "status", nil)
+}
+
+// Send a keep-alive every "interval", e.g. DefaultKeepAliveInterval, until the context is done or a keep-alive fails
+func (w *Webcam) KeepAlive(ctx context.Context, interval time.Duration) error {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+
+		if err := w.client.KeepAlive(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("keep-alive: %w", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+	}
+
+}